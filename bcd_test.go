@@ -0,0 +1,93 @@
+// Copyright (c) 2025, Oh Inseo (YJK) - Licensed under BSD-2-Clause
+package main
+
+import "testing"
+
+// Expected values follow the 68000 hardware behavior (including invalid BCD inputs, and undefined N and V flags).
+type bcdTestCase struct {
+	dest, src uint8
+	x         bool
+	res       uint8
+	c, v, n   bool
+}
+
+func runBcdTests(t *testing.T, name string, op func(ctx *clientContext, dest, src uint8) uint8, cases []bcdTestCase) {
+	t.Helper()
+	for _, tc := range cases {
+		ctx := &clientContext{ccrX: tc.x, ccrZ: true}
+		res := op(ctx, tc.dest, tc.src)
+		if (res != tc.res) || (ctx.ccrC != tc.c) || (ctx.ccrX != tc.c) || (ctx.ccrV != tc.v) || (ctx.ccrN != tc.n) || (ctx.ccrZ != (res == 0)) {
+			t.Errorf("%s %#02x, %#02x (X=%v): got %#02x C=%v X=%v V=%v N=%v Z=%v, want %#02x C=%v V=%v N=%v Z=%v",
+				name, tc.dest, tc.src, tc.x,
+				res, ctx.ccrC, ctx.ccrX, ctx.ccrV, ctx.ccrN, ctx.ccrZ,
+				tc.res, tc.c, tc.v, tc.n, tc.res == 0)
+		}
+	}
+}
+
+func TestAbcd(t *testing.T) {
+	runBcdTests(t, "abcd", (*clientContext).abcdAndSetFlags, []bcdTestCase{
+		{dest: 0x45, src: 0x38, res: 0x83, v: true, n: true},
+		{dest: 0x99, src: 0x01, res: 0x00, c: true},
+		{dest: 0x99, src: 0x99, x: true, res: 0x99, c: true, v: true, n: true},
+		// Invalid BCD inputs
+		{dest: 0x0a, src: 0x00, res: 0x10},
+		{dest: 0x0f, src: 0x0f, res: 0x24},
+		{dest: 0x9a, src: 0x00, res: 0x00, c: true},
+		{dest: 0xff, src: 0xff, x: true, res: 0x65, c: true},
+		// Bit 7 was already set before the correction, so V is not set.
+		{dest: 0x7f, src: 0x01, res: 0x86, n: true},
+	})
+}
+
+func TestSbcd(t *testing.T) {
+	runBcdTests(t, "sbcd", (*clientContext).sbcdAndSetFlags, []bcdTestCase{
+		{dest: 0x45, src: 0x38, res: 0x07},
+		{dest: 0x00, src: 0x01, res: 0x99, c: true, n: true},
+		{dest: 0x80, src: 0x01, res: 0x79},
+		// Invalid BCD inputs
+		{dest: 0x0a, src: 0x00, res: 0x0a},
+		{dest: 0x9a, src: 0x00, x: true, res: 0x99, n: true},
+		{dest: 0x00, src: 0x0f, res: 0x8b, c: true, n: true},
+		{dest: 0x00, src: 0xff, x: true, res: 0x9a, c: true, n: true},
+	})
+}
+
+func TestNbcd(t *testing.T) {
+	// NBCD is 0 - src - X
+	nbcd := func(ctx *clientContext, _, src uint8) uint8 { return ctx.sbcdAndSetFlags(0, src) }
+	runBcdTests(t, "nbcd", nbcd, []bcdTestCase{
+		{src: 0x00, res: 0x00},
+		{src: 0x00, x: true, res: 0x99, c: true, n: true},
+		{src: 0x01, res: 0x99, c: true, n: true},
+		{src: 0x45, res: 0x55, c: true, v: true},
+		{src: 0x0f, res: 0x8b, c: true, n: true},
+	})
+}
+
+// Multi-precision BCD arithmetic relies on X carrying into the next byte, and Z only being cleared.
+func TestBcdChaining(t *testing.T) {
+	ctx := &clientContext{ccrZ: true}
+	// 0x0199 + 0x0001, lowest byte first
+	lo := ctx.abcdAndSetFlags(0x99, 0x01)
+	hi := ctx.abcdAndSetFlags(0x01, 0x00)
+	if (lo != 0x00) || (hi != 0x02) || ctx.ccrX || ctx.ccrZ {
+		t.Errorf("abcd chain: got %#02x%02x X=%v Z=%v, want 0x0200 X=false Z=false", hi, lo, ctx.ccrX, ctx.ccrZ)
+	}
+
+	// 0x0100 - 0x0100: Z must stay set because every byte is zero.
+	ctx = &clientContext{ccrZ: true}
+	lo = ctx.sbcdAndSetFlags(0x00, 0x00)
+	hi = ctx.sbcdAndSetFlags(0x01, 0x01)
+	if (lo != 0x00) || (hi != 0x00) || ctx.ccrX || !ctx.ccrZ {
+		t.Errorf("sbcd chain: got %#02x%02x X=%v Z=%v, want 0x0000 X=false Z=true", hi, lo, ctx.ccrX, ctx.ccrZ)
+	}
+
+	// 0x0100 - 0x0001: Borrow from the low byte goes into the high byte through X.
+	ctx = &clientContext{ccrZ: true}
+	lo = ctx.sbcdAndSetFlags(0x00, 0x01)
+	hi = ctx.sbcdAndSetFlags(0x01, 0x00)
+	if (lo != 0x99) || (hi != 0x00) || ctx.ccrX || ctx.ccrZ {
+		t.Errorf("sbcd borrow chain: got %#02x%02x X=%v Z=%v, want 0x0099 X=false Z=false", hi, lo, ctx.ccrX, ctx.ccrZ)
+	}
+}
@@ -0,0 +1,503 @@
+// Copyright (c) 2025, Oh Inseo (YJK) - Licensed under BSD-2-Clause
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"testing"
+)
+
+// Tests below run the CPU against 64K of server-side RAM. Every exception vector points to its own handler,
+// and the program is placed at testProgramPc.
+const (
+	testSsp        = 0x4000
+	testProgramPc  = 0x1000
+	testHandlerPcs = 0x8000 // Handler for vector N is at testHandlerPcs + N*0x10
+	testRamSize    = 0x10000
+)
+
+func testHandlerPc(exc exc) uint32 {
+	return testHandlerPcs + uint32(exc)*0x10
+}
+
+// Bus cycle seen by testClient
+type testBusCycle struct {
+	addr uint32
+	fc   fc
+	dir  busDir
+	ds   netDs
+	v    uint16 // Written value
+}
+
+// Fake client that receives bus cycles not handled by the server.
+type testClient struct {
+	hole   [2]uint32                   // Start and end of RAM range that goes to the client instead
+	reply  func(c testBusCycle) []byte // Response to the bus cycle. If nil, every bus cycle gets bus error.
+	cycles []testBusCycle
+}
+
+func (cl *testClient) attach(t *testing.T, ctx *clientContext) {
+	if cl.hole[0] != cl.hole[1] {
+		ram := ctx.memRegions[0].data
+		ctx.memRegions = []memRegion{
+			{start: 0, data: ram[:cl.hole[0]]},
+			{start: cl.hole[1], data: ram[cl.hole[1]:]},
+		}
+	}
+	srv, cli := net.Pipe()
+	t.Cleanup(func() { cli.Close() })
+	ctx.conn = srv
+	ctx.reader = bufio.NewReader(srv)
+	ctx.busMode = netBusModeCombined
+	go func() {
+		event := make([]byte, 10)
+		for {
+			if _, err := io.ReadFull(cli, event); err != nil {
+				return
+			}
+			if netOpbyte(event[0]) != netOpbyteEventBusCycle {
+				t.Errorf("unexpected event %#x", event[0])
+				return
+			}
+			c := testBusCycle{
+				addr: binary.BigEndian.Uint32(event[1:]),
+				fc:   fc(event[5]),
+				dir:  busDir(event[6]),
+				ds:   netDs(event[7]),
+				v:    binary.BigEndian.Uint16(event[8:]),
+			}
+			cl.cycles = append(cl.cycles, c)
+			resp := []byte{uint8(netOpbyteFail)}
+			if cl.reply != nil {
+				resp = cl.reply(c)
+			}
+			if _, err := cli.Write(resp); err != nil {
+				return
+			}
+		}
+	}()
+}
+
+func newTestCpu(sr uint16, program ...uint16) *clientContext {
+	ctx := &clientContext{memRegions: []memRegion{{start: 0, data: make([]uint8, testRamSize)}}}
+	for v := uint32(2); v < 256; v++ {
+		binary.BigEndian.PutUint32(ctx.memRegions[0].data[v*4:], testHandlerPc(exc(v)))
+	}
+	for i, w := range program {
+		binary.BigEndian.PutUint16(ctx.memRegions[0].data[testProgramPc+i*2:], w)
+	}
+	ctx.writeSr(sr)
+	ctx.a7ssp = testSsp
+	ctx.pc = testProgramPc
+	return ctx
+}
+
+func (ctx *clientContext) testMemW(addr uint32) uint16 {
+	r := ctx.findMemRegion(addr, 2)
+	return binary.BigEndian.Uint16(r.data[addr-r.start:])
+}
+func (ctx *clientContext) testMemL(addr uint32) uint32 {
+	r := ctx.findMemRegion(addr, 4)
+	return binary.BigEndian.Uint32(r.data[addr-r.start:])
+}
+
+// Expected exception stack frame
+type testFrame struct {
+	sr uint16
+	pc uint32
+
+	// For bus/address error frames only
+	memExc    bool
+	flags     uint16 // Lower 5 bits of the first word (R/W, I/N, FC)
+	faultAddr uint32
+}
+
+func (f testFrame) size() uint32 {
+	if f.memExc {
+		return 14
+	}
+	return 6
+}
+
+// Checks that frames (listed from the top of the stack) are the only ones on the supervisor stack.
+func checkFrames(t *testing.T, ctx *clientContext, name string, frames []testFrame) {
+	t.Helper()
+	addr := uint32(testSsp)
+	for _, f := range frames {
+		addr -= f.size()
+	}
+	if ctx.a7ssp != addr {
+		t.Errorf("%s: got SSP=%#x, want %#x", name, ctx.a7ssp, addr)
+		return
+	}
+	for i, f := range frames {
+		if f.memExc {
+			gotFlags, gotFaultAddr := ctx.testMemW(addr)&0x1f, ctx.testMemL(addr+2)
+			if (gotFlags != f.flags) || (gotFaultAddr != f.faultAddr) {
+				t.Errorf("%s: frame %d: got flags=%#02x address=%#x, want flags=%#02x address=%#x", name, i, gotFlags, gotFaultAddr, f.flags, f.faultAddr)
+			}
+			addr += 8
+		}
+		if gotSr, gotPc := ctx.testMemW(addr), ctx.testMemL(addr+2); (gotSr != f.sr) || (gotPc != f.pc) {
+			t.Errorf("%s: frame %d: got SR=%#04x PC=%#x, want SR=%#04x PC=%#x", name, i, gotSr, gotPc, f.sr, f.pc)
+		}
+		addr += 6
+	}
+}
+
+type excTestCase struct {
+	name    string
+	sr      uint16
+	program []uint16
+	client  *testClient              // If set, accesses outside of RAM go to this client
+	setup   func(ctx *clientContext) // Extra setup, e.g. registers
+	ticks   int                      // 1 if not set
+
+	pc     uint32 // Expected PC after the ticks
+	frames []testFrame
+	check  func(t *testing.T, ctx *clientContext) // Extra checks, if needed
+}
+
+func runExcTests(t *testing.T, cases []excTestCase) {
+	t.Helper()
+	for _, tc := range cases {
+		ctx := newTestCpu(tc.sr, tc.program...)
+		if tc.client != nil {
+			tc.client.attach(t, ctx)
+		}
+		if tc.setup != nil {
+			tc.setup(ctx)
+		}
+		for range max(tc.ticks, 1) {
+			if _, err := ctx.tick(log.New(io.Discard, "", 0)); err != nil {
+				t.Fatalf("%s: tick failed: %v", tc.name, err)
+			}
+		}
+		if ctx.pc != tc.pc {
+			t.Errorf("%s: got PC=%#x, want %#x", tc.name, ctx.pc, tc.pc)
+		}
+		checkFrames(t, ctx, tc.name, tc.frames)
+		if tc.check != nil {
+			tc.check(t, ctx)
+		}
+	}
+}
+
+// Run with netRunFlagUntilExc must stop at TRAP even when trace is off.
+func TestRunUntilTrap(t *testing.T) {
+	ctx := newTestCpu(0x2700, 0x4e71, 0x4e41, 0x4e71)
+	count, reason, err := ctx.run(log.New(io.Discard, "", 0), 10, netRunFlagUntilExc, 0)
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if (count != 2) || (reason != netRunReasonExc) {
+		t.Errorf("got count=%d reason=%d, want count=2 reason=%d", count, reason, netRunReasonExc)
+	}
+	checkFrames(t, ctx, "trap", []testFrame{{sr: 0x2700, pc: testProgramPc + 4}})
+}
+
+// Address error on an operand stacks the PC after extension words of that operand.
+// For MOVE, extension words of the destination are not fetched yet when the source is read.
+func TestMemExcStackedPc(t *testing.T) {
+	oddA0 := func(ctx *clientContext) { ctx.writeAregL(0, 0x3001) }
+	addrErr := testHandlerPc(excAddressError)
+	runExcTests(t, []excTestCase{
+		{
+			name: "move source", sr: 0x2700, setup: oddA0,
+			program: []uint16{0x33d0, 0x0000, 0x2000}, // move.w (a0), $2000.l
+			pc:      addrErr,
+			frames:  []testFrame{{memExc: true, flags: 0x15, faultAddr: 0x3001, sr: 0x2700, pc: testProgramPc + 2}},
+		},
+		{
+			name: "move source with displacement", sr: 0x2700, setup: oddA0,
+			program: []uint16{0x33e8, 0x0010, 0x0000, 0x2000}, // move.w $10(a0), $2000.l
+			pc:      addrErr,
+			frames:  []testFrame{{memExc: true, flags: 0x15, faultAddr: 0x3011, sr: 0x2700, pc: testProgramPc + 4}},
+		},
+		{
+			name: "move destination", sr: 0x2700,
+			program: []uint16{0x33c0, 0x0000, 0x3001}, // move.w d0, $3001.l
+			pc:      addrErr,
+			frames:  []testFrame{{memExc: true, flags: 0x05, faultAddr: 0x3001, sr: 0x2700, pc: testProgramPc + 6}},
+		},
+		{
+			name: "read-modify-write", sr: 0x2700, setup: oddA0,
+			program: []uint16{0x0668, 0x0001, 0x0010}, // addi.w #1, $10(a0)
+			pc:      addrErr,
+			frames:  []testFrame{{memExc: true, flags: 0x15, faultAddr: 0x3011, sr: 0x2700, pc: testProgramPc + 6}},
+		},
+	})
+}
+
+// Instructions that were aborted before being executed stack their own address.
+func TestAbortedInstrStackedPc(t *testing.T) {
+	runExcTests(t, []excTestCase{
+		{
+			name: "illegal", sr: 0x2700,
+			program: []uint16{0x4afc}, // illegal
+			pc:      testHandlerPc(excIllegalInstr),
+			frames:  []testFrame{{sr: 0x2700, pc: testProgramPc}},
+		},
+		{
+			name: "line a", sr: 0x2700,
+			program: []uint16{0xa000},
+			pc:      testHandlerPc(excLineA),
+			frames:  []testFrame{{sr: 0x2700, pc: testProgramPc}},
+		},
+		{
+			name: "privilege violation", sr: 0x0700,
+			program: []uint16{0x46fc, 0x2700}, // move #$2700, sr
+			pc:      testHandlerPc(excPrivilegeViolation),
+			frames:  []testFrame{{sr: 0x0700, pc: testProgramPc}},
+		},
+	})
+}
+
+// Bus error while processing another exception takes over. Its frame has the SR before any exception processing.
+func TestNestedMemExc(t *testing.T) {
+	runExcTests(t, []excTestCase{
+		{
+			// Trace exception that would follow TRAP is discarded.
+			name: "trap vector fetch under trace", sr: 0x8004,
+			program: []uint16{0x4e41}, // trap #1
+			client:  &testClient{hole: [2]uint32{0x84, 0x88}},
+			pc:      testHandlerPc(excBusError),
+			// Supervisor data read, while processing an exception
+			frames: []testFrame{{memExc: true, flags: 0x1d, faultAddr: 0x84, sr: 0x8004, pc: testProgramPc + 2}},
+		},
+	})
+}
+
+func TestTrace(t *testing.T) {
+	trace := testHandlerPc(excTrace)
+	runExcTests(t, []excTestCase{
+		{
+			name: "nop", sr: 0xa700,
+			program: []uint16{0x4e71}, // nop
+			pc:      trace,
+			frames:  []testFrame{{sr: 0xa700, pc: testProgramPc + 2}},
+		},
+		// T is sampled at the beginning of the instruction.
+		{
+			name: "move to sr setting t", sr: 0x2700, ticks: 2,
+			program: []uint16{0x46fc, 0xa700, 0x4e71}, // move #$a700, sr; nop
+			pc:      trace,
+			frames:  []testFrame{{sr: 0xa700, pc: testProgramPc + 6}},
+		},
+		{
+			name: "andi to sr clearing t", sr: 0xa700,
+			program: []uint16{0x027c, 0x7fff}, // andi #$7fff, sr
+			pc:      trace,
+			frames:  []testFrame{{sr: 0x2700, pc: testProgramPc + 4}},
+		},
+		// Group 2 exceptions are part of the instruction, so trace exception follows them.
+		// Trace frame holds the handler address, and the handler runs after the trace handler.
+		{
+			name: "trap", sr: 0xa700,
+			program: []uint16{0x4e41}, // trap #1
+			pc:      trace,
+			frames: []testFrame{
+				{sr: 0x2700, pc: testHandlerPc(excTrapVectorStart + 1)},
+				{sr: 0xa700, pc: testProgramPc + 2},
+			},
+		},
+		{
+			name: "trapv", sr: 0xa702,
+			program: []uint16{0x4e76}, // trapv
+			pc:      trace,
+			frames: []testFrame{
+				{sr: 0x2702, pc: testHandlerPc(excTrapv)},
+				{sr: 0xa702, pc: testProgramPc + 2},
+			},
+		},
+		{
+			name: "divide by zero", sr: 0xa700,
+			program: []uint16{0x80fc, 0x0000}, // divu #0, d0
+			pc:      trace,
+			frames: []testFrame{
+				{sr: 0x2700, pc: testHandlerPc(excZeroDivide)},
+				{sr: 0xa700, pc: testProgramPc + 4},
+			},
+		},
+		// Instructions that were not executed are not traced.
+		{
+			name: "privilege violation", sr: 0x8000,
+			program: []uint16{0x46fc, 0x0000}, // move #0, sr
+			pc:      testHandlerPc(excPrivilegeViolation),
+			frames:  []testFrame{{sr: 0x8000, pc: testProgramPc}},
+		},
+		{
+			name: "illegal instruction", sr: 0xa700,
+			program: []uint16{0x4afc}, // illegal
+			pc:      testHandlerPc(excIllegalInstr),
+			frames:  []testFrame{{sr: 0xa700, pc: testProgramPc}},
+		},
+		// STOP leaves stopped state right away to process the trace exception.
+		{
+			name: "stop", sr: 0xa700,
+			program: []uint16{0x4e72, 0xa700}, // stop #$a700
+			pc:      trace,
+			frames:  []testFrame{{sr: 0xa700, pc: testProgramPc + 4}},
+			check: func(t *testing.T, ctx *clientContext) {
+				if ctx.stopped {
+					t.Errorf("stop: CPU is still stopped")
+				}
+			},
+		},
+		// Interrupt unmasked by the traced instruction is processed after trace exception, so its handler runs first.
+		{
+			name: "interrupt after trace", sr: 0xa700, ticks: 2,
+			program: []uint16{0x46fc, 0xa000}, // move #$a000, sr
+			client:  &testClient{reply: func(testBusCycle) []byte { return []byte{uint8(netOpbyteAutovector)} }},
+			setup:   func(ctx *clientContext) { ctx.setIpl(3) },
+			pc:      testHandlerPc(excLevel1InterruptAutovector + 2),
+			frames: []testFrame{
+				{sr: 0x2000, pc: trace},
+				{sr: 0xa000, pc: testProgramPc + 4},
+			},
+		},
+	})
+}
+
+// Interrupt is processed as group 1 exception, even if the device gives vector number of bus/address error.
+func TestInterruptWithMemExcVector(t *testing.T) {
+	var cases []excTestCase
+	for _, vector := range []exc{excBusError, excAddressError} {
+		cases = append(cases, excTestCase{
+			name: fmt.Sprintf("vector %d", vector), sr: 0x2000,
+			program: []uint16{0x4e71},
+			client:  &testClient{reply: func(testBusCycle) []byte { return []byte{uint8(netOpbyteAck), 0x00, uint8(vector)} }},
+			setup:   func(ctx *clientContext) { ctx.setIpl(3) },
+			pc:      testHandlerPc(vector),
+			frames:  []testFrame{{sr: 0x2000, pc: testProgramPc}},
+		})
+	}
+	runExcTests(t, cases)
+}
+
+// Interrupt acknowledge is a read cycle in CPU space, and device's response decides the vector.
+func TestInterruptAck(t *testing.T) {
+	const level = 3
+	iackAddr := uint32(0xfffff0 | (level << 1)) // A0 is carried by LDS
+	newClient := func(iackResp ...byte) *testClient {
+		return &testClient{
+			// Vector 0 and 1 are read from the client, to see the function code.
+			hole: [2]uint32{0, 8},
+			reply: func(c testBusCycle) []byte {
+				if c.fc == fcCpuSpace {
+					return iackResp
+				}
+				// Vector 0 and 1 point to 0x9000
+				if c.addr == 2 || c.addr == 6 {
+					return []byte{uint8(netOpbyteAck), 0x90, 0x00}
+				}
+				return []byte{uint8(netOpbyteAck), 0x00, 0x00}
+			},
+		}
+	}
+	// Checks the IACK cycle, and function code of vector fetch(if it's done by the client).
+	checkCycles := func(name string, cl *testClient) func(t *testing.T, ctx *clientContext) {
+		return func(t *testing.T, ctx *clientContext) {
+			if len(cl.cycles) == 0 {
+				t.Fatalf("%s: no bus cycles", name)
+			}
+			iack := cl.cycles[0]
+			if (iack.addr != iackAddr) || (iack.fc != fcCpuSpace) || (iack.dir != busDirRead) || (iack.ds != netDsLower) {
+				t.Errorf("%s: got IACK cycle %+v, want read at %#x with FC=%d DS=%d", name, iack, iackAddr, fcCpuSpace, netDsLower)
+			}
+			for _, c := range cl.cycles[1:] {
+				if c.fc != fcSuperData {
+					t.Errorf("%s: vector fetch at %#x used FC=%d, want %d", name, c.addr, c.fc, fcSuperData)
+				}
+			}
+		}
+	}
+	var cases []excTestCase
+	for _, tc := range []struct {
+		name     string
+		iackResp []byte
+		pc       uint32
+	}{
+		{"vector", []byte{uint8(netOpbyteAck), 0x00, 0x40}, testHandlerPc(0x40)},
+		{"autovector", []byte{uint8(netOpbyteAutovector)}, testHandlerPc(excLevel1InterruptAutovector + level - 1)},
+		{"bus error", []byte{uint8(netOpbyteFail)}, testHandlerPc(excSpuriousInterrupt)},
+		{"vector 0", []byte{uint8(netOpbyteAck), 0x00, 0x00}, 0x9000},
+		{"vector 1", []byte{uint8(netOpbyteAck), 0x00, 0x01}, 0x9000},
+		{"uninitialized vector", []byte{uint8(netOpbyteAck), 0x00, 0x0f}, testHandlerPc(0xf)},
+	} {
+		cl := newClient(tc.iackResp...)
+		cases = append(cases, excTestCase{
+			name: tc.name, sr: 0x2000,
+			program: []uint16{0x4e71},
+			client:  cl,
+			setup:   func(ctx *clientContext) { ctx.setIpl(level) },
+			pc:      tc.pc,
+			// Interrupt mask is raised after SR is stacked.
+			frames: []testFrame{{sr: 0x2000, pc: testProgramPc}},
+			check:  checkCycles(tc.name, cl),
+		})
+	}
+	runExcTests(t, cases)
+}
+
+// For long -(An) operands, 68000 accesses the low word first. This decides the bus cycle order, and which address
+// is reported by address error.
+func TestPredecLongBusOrder(t *testing.T) {
+	type cycle struct {
+		dir  busDir
+		addr uint32
+		v    uint16 // Written value
+	}
+	r := func(addr uint32) cycle { return cycle{dir: busDirRead, addr: addr} }
+	w := func(addr uint32, v uint16) cycle { return cycle{dir: busDirWrite, addr: addr, v: v} }
+	for _, tc := range []struct {
+		name   string
+		ir     uint16
+		cycles []cycle
+	}{
+		// Source is 0x00010002 at 0x2000c, and destination is 0x00030004 at 0x20004.
+		{"addx.l -(a1), -(a0)", 0xd189, []cycle{r(0x2000e), r(0x2000c), r(0x20006), r(0x20004), w(0x20006, 0x0006), w(0x20004, 0x0004)}},
+		{"subx.l -(a1), -(a0)", 0x9189, []cycle{r(0x2000e), r(0x2000c), r(0x20006), r(0x20004), w(0x20006, 0x0002), w(0x20004, 0x0002)}},
+		// D0 is 0x12345678
+		{"move.l d0, -(a0)", 0x2100, []cycle{w(0x20006, 0x5678), w(0x20004, 0x1234)}},
+	} {
+		mem := map[uint32]uint16{0x2000c: 0x0001, 0x2000e: 0x0002, 0x20004: 0x0003, 0x20006: 0x0004}
+		cl := &testClient{reply: func(c testBusCycle) []byte {
+			if c.dir == busDirWrite {
+				return []byte{uint8(netOpbyteAck)}
+			}
+			v := mem[c.addr]
+			return []byte{uint8(netOpbyteAck), uint8(v >> 8), uint8(v)}
+		}}
+		ctx := newTestCpu(0x2700, tc.ir)
+		cl.attach(t, ctx)
+		ctx.writeAregL(0, 0x20008)
+		ctx.writeAregL(1, 0x20010)
+		ctx.writeDreg(0, opsizeLong, 0x12345678)
+		if _, err := ctx.tick(log.New(io.Discard, "", 0)); err != nil {
+			t.Fatalf("%s: tick failed: %v", tc.name, err)
+		}
+		var got []cycle
+		for _, c := range cl.cycles {
+			got = append(got, cycle{dir: c.dir, addr: c.addr, v: c.v})
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tc.cycles) {
+			t.Errorf("%s: got bus cycles %+v, want %+v", tc.name, got, tc.cycles)
+		}
+	}
+
+	// Address error is reported at the low word, because it's accessed first.
+	oddA1 := func(ctx *clientContext) { ctx.writeAregL(1, 0x3011) }
+	runExcTests(t, []excTestCase{
+		{
+			name: "addx.l odd source", sr: 0x2700, setup: oddA1,
+			program: []uint16{0xd189},
+			pc:      testHandlerPc(excAddressError),
+			frames:  []testFrame{{memExc: true, flags: 0x15, faultAddr: 0x300f, sr: 0x2700, pc: testProgramPc + 2}},
+		},
+	})
+}
@@ -1,7 +1,23 @@
 // This file was automatically generated.
-// Generated at 2025-04-07 17:54:23
+// Generated at 2026-10-18 07:19:57
 package main
 
+type instrMoveAW struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrMoveAL struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
 type instrMoveB struct {
     instrPc uint32
     
@@ -10,199 +26,3492 @@ type instrMoveB struct {
     
 }
 
-type instrBra struct {
+type instrMoveW struct {
     instrPc uint32
     
+    ea1 *ea
+    ea2 *ea
     
-    branchOff uint32
 }
 
-type instrBsr struct {
+type instrMoveL struct {
     instrPc uint32
     
+    ea1 *ea
+    ea2 *ea
     
-    branchOff uint32
 }
 
-type instrBcc struct {
+type instrMoveQ struct {
     instrPc uint32
     
-    cond cond
+    regX uint8
+    imm uint8
     
-    branchOff uint32
 }
 
-type instrDbcc struct {
+type instrAddAW struct {
     instrPc uint32
     
-    cond cond
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrAddAL struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrAddXReg struct {
+    instrPc uint32
+    
+    regX uint8
+    size opsize
     regY uint8
     
-    imm16 uint16
 }
 
-type instrLea struct {
+type instrAddXMem struct {
+    instrPc uint32
+    
+    regX uint8
+    size opsize
+    regY uint8
+    
+}
+
+type instrAddEaDreg struct {
     instrPc uint32
     
     regX uint8
+    size opsize
     ea1 *ea
     
 }
 
-type instrPea struct {
+type instrAddDregEa struct {
     instrPc uint32
     
+    regX uint8
+    size opsize
     ea1 *ea
     
 }
 
-type instrJmp struct {
+type instrAddI struct {
     instrPc uint32
     
+    size opsize
     ea1 *ea
     
+    imm uint32
 }
 
-type instrJsr struct {
+type instrAddQ struct {
     instrPc uint32
     
+    imm uint8
+    size opsize
     ea1 *ea
     
 }
 
-type instrLink struct {
+type instrSubAW struct {
     instrPc uint32
     
-    regY uint8
+    regX uint8
+    ea1 *ea
     
-    imm16 uint16
 }
 
-type instrUnlk struct {
+type instrSubAL struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrSubXReg struct {
     instrPc uint32
     
+    regX uint8
+    size opsize
     regY uint8
     
 }
 
-type instrSwap struct {
+type instrSubXMem struct {
     instrPc uint32
     
+    regX uint8
+    size opsize
     regY uint8
     
 }
 
-type instrMoveToUsp struct {
+type instrSubEaDreg struct {
+    instrPc uint32
+    
+    regX uint8
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrSubDregEa struct {
+    instrPc uint32
+    
+    regX uint8
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrSubI struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+    imm uint32
+}
+
+type instrSubQ struct {
+    instrPc uint32
+    
+    imm uint8
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrAbcdReg struct {
     instrPc uint32
     
+    regX uint8
     regY uint8
     
 }
 
-type instrMoveFromUsp struct {
+type instrAbcdMem struct {
     instrPc uint32
     
+    regX uint8
     regY uint8
     
 }
 
-type instrExtW struct {
+type instrSbcdReg struct {
     instrPc uint32
     
+    regX uint8
     regY uint8
     
 }
 
-type instrExtL struct {
+type instrSbcdMem struct {
     instrPc uint32
     
+    regX uint8
     regY uint8
     
 }
 
-type instrTrap struct {
+type instrNbcd struct {
     instrPc uint32
     
-    vector uint8
+    ea1 *ea
     
 }
 
-type instrTrapV struct {
+type instrCmpAW struct {
     instrPc uint32
     
+    regX uint8
+    ea1 *ea
     
 }
 
-type instrExgDReg struct {
+type instrCmpAL struct {
     instrPc uint32
     
     regX uint8
-    regY uint8
+    ea1 *ea
     
 }
 
-type instrExgAReg struct {
+type instrCmpM struct {
     instrPc uint32
     
     regX uint8
+    size opsize
     regY uint8
     
 }
 
-type instrExgDAReg struct {
+type instrCmp struct {
     instrPc uint32
     
     regX uint8
-    regY uint8
+    size opsize
+    ea1 *ea
     
 }
 
-type instrIllegal struct {
+type instrCmpI struct {
     instrPc uint32
     
+    size opsize
+    ea1 *ea
     
+    imm uint32
 }
 
-type instrNop struct {
+type instrTst struct {
     instrPc uint32
     
+    size opsize
+    ea1 *ea
     
 }
 
-type instrRts struct {
+type instrMulU struct {
     instrPc uint32
     
+    regX uint8
+    ea1 *ea
     
 }
 
-type instrRtr struct {
+type instrMulS struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrDivU struct {
     instrPc uint32
     
+    regX uint8
+    ea1 *ea
     
 }
 
-type instrReset struct {
+type instrDivS struct {
     instrPc uint32
     
+    regX uint8
+    ea1 *ea
     
 }
 
-type instrRte struct {
+type instrAndEaDreg struct {
     instrPc uint32
     
+    regX uint8
+    size opsize
+    ea1 *ea
     
 }
 
-//==========================================================================
-// Decoder function
-//==========================================================================
-func (ctx *clientContext) instrDecode() (res instr, err error) {
-    // instrMoveB
+type instrAndDregEa struct {
+    instrPc uint32
+    
+    regX uint8
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrAndI struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+    imm uint32
+}
+
+type instrOrEaDreg struct {
+    instrPc uint32
+    
+    regX uint8
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrOrDregEa struct {
+    instrPc uint32
+    
+    regX uint8
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrOrI struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+    imm uint32
+}
+
+type instrEor struct {
+    instrPc uint32
+    
+    regX uint8
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrEorI struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+    imm uint32
+}
+
+type instrNot struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrMoveFromSr struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+}
+
+type instrMoveToCcr struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+}
+
+type instrMoveToSr struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+}
+
+type instrAndIToCcr struct {
+    instrPc uint32
+    
+    
+    imm8 uint8
+}
+
+type instrAndIToSr struct {
+    instrPc uint32
+    
+    
+    imm16 uint16
+}
+
+type instrOrIToCcr struct {
+    instrPc uint32
+    
+    
+    imm8 uint8
+}
+
+type instrOrIToSr struct {
+    instrPc uint32
+    
+    
+    imm16 uint16
+}
+
+type instrEorIToCcr struct {
+    instrPc uint32
+    
+    
+    imm8 uint8
+}
+
+type instrEorIToSr struct {
+    instrPc uint32
+    
+    
+    imm16 uint16
+}
+
+type instrNegX struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrClr struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrNeg struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+}
+
+type instrTas struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+}
+
+type instrScc struct {
+    instrPc uint32
+    
+    cond cond
+    ea1 *ea
+    
+}
+
+type instrShiftImm struct {
+    instrPc uint32
+    
+    imm uint8
+    dir shiftDir
+    size opsize
+    kind shiftKind
+    regY uint8
+    
+}
+
+type instrShiftReg struct {
+    instrPc uint32
+    
+    regX uint8
+    dir shiftDir
+    size opsize
+    kind shiftKind
+    regY uint8
+    
+}
+
+type instrShiftMem struct {
+    instrPc uint32
+    
+    kind shiftKind
+    dir shiftDir
+    ea1 *ea
+    
+}
+
+type instrMovepToRegW struct {
+    instrPc uint32
+    
+    regX uint8
+    regY uint8
+    
+    imm16 uint16
+}
+
+type instrMovepToRegL struct {
+    instrPc uint32
+    
+    regX uint8
+    regY uint8
+    
+    imm16 uint16
+}
+
+type instrMovepToMemW struct {
+    instrPc uint32
+    
+    regX uint8
+    regY uint8
+    
+    imm16 uint16
+}
+
+type instrMovepToMemL struct {
+    instrPc uint32
+    
+    regX uint8
+    regY uint8
+    
+    imm16 uint16
+}
+
+type instrBtstImm struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+    bitNum uint8
+}
+
+type instrBchgImm struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+    bitNum uint8
+}
+
+type instrBclrImm struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+    bitNum uint8
+}
+
+type instrBsetImm struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+    bitNum uint8
+}
+
+type instrBtstReg struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrBchgReg struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrBclrReg struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrBsetReg struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrBra struct {
+    instrPc uint32
+    
+    
+    branchOff uint32
+}
+
+type instrBsr struct {
+    instrPc uint32
+    
+    
+    branchOff uint32
+}
+
+type instrBcc struct {
+    instrPc uint32
+    
+    cond cond
+    
+    branchOff uint32
+}
+
+type instrDbcc struct {
+    instrPc uint32
+    
+    cond cond
+    regY uint8
+    
+    imm16 uint16
+}
+
+type instrMovemToMem struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+    regList uint16
+}
+
+type instrMovemToReg struct {
+    instrPc uint32
+    
+    size opsize
+    ea1 *ea
+    
+    regList uint16
+}
+
+type instrLea struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrPea struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+}
+
+type instrJmp struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+}
+
+type instrJsr struct {
+    instrPc uint32
+    
+    ea1 *ea
+    
+}
+
+type instrLink struct {
+    instrPc uint32
+    
+    regY uint8
+    
+    imm16 uint16
+}
+
+type instrUnlk struct {
+    instrPc uint32
+    
+    regY uint8
+    
+}
+
+type instrSwap struct {
+    instrPc uint32
+    
+    regY uint8
+    
+}
+
+type instrMoveToUsp struct {
+    instrPc uint32
+    
+    regY uint8
+    
+}
+
+type instrMoveFromUsp struct {
+    instrPc uint32
+    
+    regY uint8
+    
+}
+
+type instrExtW struct {
+    instrPc uint32
+    
+    regY uint8
+    
+}
+
+type instrExtL struct {
+    instrPc uint32
+    
+    regY uint8
+    
+}
+
+type instrChk struct {
+    instrPc uint32
+    
+    regX uint8
+    ea1 *ea
+    
+}
+
+type instrTrap struct {
+    instrPc uint32
+    
+    vector uint8
+    
+}
+
+type instrTrapV struct {
+    instrPc uint32
+    
+    
+}
+
+type instrExgDReg struct {
+    instrPc uint32
+    
+    regX uint8
+    regY uint8
+    
+}
+
+type instrExgAReg struct {
+    instrPc uint32
+    
+    regX uint8
+    regY uint8
+    
+}
+
+type instrExgDAReg struct {
+    instrPc uint32
+    
+    regX uint8
+    regY uint8
+    
+}
+
+type instrStop struct {
+    instrPc uint32
+    
+    
+    imm16 uint16
+}
+
+type instrIllegal struct {
+    instrPc uint32
+    
+    
+}
+
+type instrNop struct {
+    instrPc uint32
+    
+    
+}
+
+type instrRts struct {
+    instrPc uint32
+    
+    
+}
+
+type instrRtr struct {
+    instrPc uint32
+    
+    
+}
+
+type instrReset struct {
+    instrPc uint32
+    
+    
+}
+
+type instrRte struct {
+    instrPc uint32
+    
+    
+}
+
+//==========================================================================
+// Decoder function
+//==========================================================================
+func (ctx *clientContext) instrDecode() (res instr, err error) {
+    // instrMoveAW
+    func() {
+        err = nil
+        resTemp := instrMoveAW{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "moveaw"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x3040 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMoveAL
+    func() {
+        err = nil
+        resTemp := instrMoveAL{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "moveal"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeLong
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x2040 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMoveB
+    func() {
+        err = nil
+        resTemp := instrMoveB{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "moveb"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf000) != 0x1000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if v, ok := ctx.decodeFieldEa2(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea2 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMoveW
+    func() {
+        err = nil
+        resTemp := instrMoveW{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movew"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf000) != 0x3000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if v, ok := ctx.decodeFieldEa2(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea2 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMoveL
+    func() {
+        err = nil
+        resTemp := instrMoveL{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movel"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeLong
+        if (ctx.decodingCtx.ir & 0xf000) != 0x2000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if v, ok := ctx.decodeFieldEa2(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea2 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMoveQ
+    func() {
+        err = nil
+        resTemp := instrMoveQ{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "moveq"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeLong
+        if (ctx.decodingCtx.ir & 0xf100) != 0x7000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldImm8(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAddAW
+    func() {
+        err = nil
+        resTemp := instrAddAW{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "addaw"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0xd0c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAddAL
+    func() {
+        err = nil
+        resTemp := instrAddAL{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "addal"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeLong
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0xd1c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAddXReg
+    func() {
+        err = nil
+        resTemp := instrAddXReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "addxreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf138) != 0xd100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAddXMem
+    func() {
+        err = nil
+        resTemp := instrAddXMem{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "addxmem"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf138) != 0xd108 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAddEaDreg
+    func() {
+        err = nil
+        resTemp := instrAddEaDreg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "addeadreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0xd000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAddDregEa
+    func() {
+        err = nil
+        resTemp := instrAddDregEa{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "adddregea"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0xd100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAddI
+    func() {
+        err = nil
+        resTemp := instrAddI{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "addi"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x600 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAddQ
+    func() {
+        err = nil
+        resTemp := instrAddQ{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "addq"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0x5000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldImm3(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSubAW
+    func() {
+        err = nil
+        resTemp := instrSubAW{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "subaw"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x90c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSubAL
+    func() {
+        err = nil
+        resTemp := instrSubAL{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "subal"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeLong
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x91c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSubXReg
+    func() {
+        err = nil
+        resTemp := instrSubXReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "subxreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf138) != 0x9100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSubXMem
+    func() {
+        err = nil
+        resTemp := instrSubXMem{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "subxmem"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf138) != 0x9108 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSubEaDreg
+    func() {
+        err = nil
+        resTemp := instrSubEaDreg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "subeadreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0x9000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSubDregEa
+    func() {
+        err = nil
+        resTemp := instrSubDregEa{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "subdregea"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0x9100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSubI
+    func() {
+        err = nil
+        resTemp := instrSubI{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "subi"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x400 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSubQ
+    func() {
+        err = nil
+        resTemp := instrSubQ{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "subq"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0x5100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldImm3(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAbcdReg
+    func() {
+        err = nil
+        resTemp := instrAbcdReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "abcdreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf1f8) != 0xc100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAbcdMem
+    func() {
+        err = nil
+        resTemp := instrAbcdMem{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "abcdmem"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf1f8) != 0xc108 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSbcdReg
+    func() {
+        err = nil
+        resTemp := instrSbcdReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "sbcdreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf1f8) != 0x8100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrSbcdMem
+    func() {
+        err = nil
+        resTemp := instrSbcdMem{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "sbcdmem"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf1f8) != 0x8108 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrNbcd
+    func() {
+        err = nil
+        resTemp := instrNbcd{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "nbcd"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x4800 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrCmpAW
+    func() {
+        err = nil
+        resTemp := instrCmpAW{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "cmpaw"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0xb0c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrCmpAL
+    func() {
+        err = nil
+        resTemp := instrCmpAL{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "cmpal"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeLong
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0xb1c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrCmpM
+    func() {
+        err = nil
+        resTemp := instrCmpM{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "cmpm"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf138) != 0xb108 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrCmp
+    func() {
+        err = nil
+        resTemp := instrCmp{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "cmp"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0xb000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrCmpI
+    func() {
+        err = nil
+        resTemp := instrCmpI{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "cmpi"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0xc00 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrTst
+    func() {
+        err = nil
+        resTemp := instrTst{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "tst"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x4a00 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMulU
+    func() {
+        err = nil
+        resTemp := instrMulU{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "mulu"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0xc0c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMulS
+    func() {
+        err = nil
+        resTemp := instrMulS{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "muls"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0xc1c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrDivU
+    func() {
+        err = nil
+        resTemp := instrDivU{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "divu"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x80c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrDivS
+    func() {
+        err = nil
+        resTemp := instrDivS{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "divs"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x81c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAndEaDreg
+    func() {
+        err = nil
+        resTemp := instrAndEaDreg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "andeadreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0xc000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAndDregEa
+    func() {
+        err = nil
+        resTemp := instrAndDregEa{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "anddregea"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0xc100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAndI
+    func() {
+        err = nil
+        resTemp := instrAndI{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "andi"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x200 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrOrEaDreg
+    func() {
+        err = nil
+        resTemp := instrOrEaDreg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "oreadreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0x8000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrOrDregEa
+    func() {
+        err = nil
+        resTemp := instrOrDregEa{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "ordregea"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0x8100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrOrI
+    func() {
+        err = nil
+        resTemp := instrOrI{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "ori"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrEor
+    func() {
+        err = nil
+        resTemp := instrEor{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "eor"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf100) != 0xb100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrEorI
+    func() {
+        err = nil
+        resTemp := instrEorI{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "eori"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0xa00 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrNot
+    func() {
+        err = nil
+        resTemp := instrNot{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "not"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x4600 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMoveFromSr
+    func() {
+        err = nil
+        resTemp := instrMoveFromSr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movefromsr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x40c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMoveToCcr
+    func() {
+        err = nil
+        resTemp := instrMoveToCcr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movetoccr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x44c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMoveToSr
+    func() {
+        err = nil
+        resTemp := instrMoveToSr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movetosr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x46c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAndIToCcr
+    func() {
+        err = nil
+        resTemp := instrAndIToCcr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "anditoccr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xffff) != 0x23c {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm8(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm8 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrAndIToSr
+    func() {
+        err = nil
+        resTemp := instrAndIToSr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "anditosr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xffff) != 0x27c {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm16 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrOrIToCcr
+    func() {
+        err = nil
+        resTemp := instrOrIToCcr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "oritoccr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xffff) != 0x3c {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm8(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm8 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrOrIToSr
+    func() {
+        err = nil
+        resTemp := instrOrIToSr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "oritosr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xffff) != 0x7c {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm16 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrEorIToCcr
+    func() {
+        err = nil
+        resTemp := instrEorIToCcr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "eoritoccr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xffff) != 0xa3c {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm8(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm8 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrEorIToSr
+    func() {
+        err = nil
+        resTemp := instrEorIToSr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "eoritosr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xffff) != 0xa7c {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm16 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrNegX
+    func() {
+        err = nil
+        resTemp := instrNegX{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "negx"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x4000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrClr
+    func() {
+        err = nil
+        resTemp := instrClr{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "clr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x4200 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrNeg
+    func() {
+        err = nil
+        resTemp := instrNeg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "neg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff00) != 0x4400 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrTas
+    func() {
+        err = nil
+        resTemp := instrTas{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "tas"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x4ac0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrScc
+    func() {
+        err = nil
+        resTemp := instrScc{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "scc"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf0c0) != 0x50c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldCond(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.cond = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrShiftImm
+    func() {
+        err = nil
+        resTemp := instrShiftImm{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "shiftimm"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf020) != 0xe000 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldImm3(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.imm = v
+        }
+        if v, ok := ctx.decodeFieldShiftDir(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.dir = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldShiftKind(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.kind = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrShiftReg
+    func() {
+        err = nil
+        resTemp := instrShiftReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "shiftreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xf020) != 0xe020 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldShiftDir(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.dir = v
+        }
+        if v, ok := ctx.decodeFieldSizeType1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldShiftKind(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.kind = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrShiftMem
+    func() {
+        err = nil
+        resTemp := instrShiftMem{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "shiftmem"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf8c0) != 0xe0c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldShiftKindMem(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.kind = v
+        }
+        if v, ok := ctx.decodeFieldShiftDir(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.dir = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMovepToRegW
+    func() {
+        err = nil
+        resTemp := instrMovepToRegW{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "moveptoregw"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1f8) != 0x108 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm16 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMovepToRegL
+    func() {
+        err = nil
+        resTemp := instrMovepToRegL{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "moveptoregl"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeLong
+        if (ctx.decodingCtx.ir & 0xf1f8) != 0x148 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm16 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMovepToMemW
+    func() {
+        err = nil
+        resTemp := instrMovepToMemW{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "moveptomemw"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1f8) != 0x188 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm16 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMovepToMemL
+    func() {
+        err = nil
+        resTemp := instrMovepToMemL{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "moveptomeml"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeLong
+        if (ctx.decodingCtx.ir & 0xf1f8) != 0x1c8 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldRegY(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regY = v
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm16 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrBtstImm
+    func() {
+        err = nil
+        resTemp := instrBtstImm{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "btstimm"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x800 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordBitNum(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.bitNum = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrBchgImm
+    func() {
+        err = nil
+        resTemp := instrBchgImm{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "bchgimm"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x840 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordBitNum(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.bitNum = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrBclrImm
+    func() {
+        err = nil
+        resTemp := instrBclrImm{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "bclrimm"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x880 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordBitNum(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.bitNum = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrBsetImm
     func() {
         err = nil
-        resTemp := instrMoveB{}
+        resTemp := instrBsetImm{}
         resTemp.instrPc = ctx.pc - 2
-        if (ctx.decodingCtx.ir & 0xf000) != 0x1000 {
+        ctx.decodingCtx.currInstrName = "bsetimm"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xffc0) != 0x8c0 {
             err = excError{exc: excIllegalInstr}
             return
         }
@@ -212,13 +3521,157 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         }else {
             resTemp.ea1 = v
         }
-        if v, ok := ctx.decodeFieldEa2(); !ok {
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
             err = excError{exc: excIllegalInstr}
             return
+        }
+        if v, xwordErr := ctx.decodeXwordBitNum(); xwordErr != nil {
+            err = xwordErr
+            return
         }else {
-            resTemp.ea2 = v
+            resTemp.bitNum = v
         }
-        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}) {
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrBtstReg
+    func() {
+        err = nil
+        resTemp := instrBtstReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "btstreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x100 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrBchgReg
+    func() {
+        err = nil
+        resTemp := instrBchgReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "bchgreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x140 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrBclrReg
+    func() {
+        err = nil
+        resTemp := instrBclrReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "bclrreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x180 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrBsetReg
+    func() {
+        err = nil
+        resTemp := instrBsetReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "bsetreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeByte
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x1c0 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
             err = excError{exc: excIllegalInstr}
             return
         }
@@ -235,6 +3688,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrBra{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "bra"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xff00) != 0x6000 {
             err = excError{exc: excIllegalInstr}
             return
@@ -243,8 +3699,8 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
             err = excError{exc: excIllegalInstr}
             return
         }
-        if v, err := ctx.decodeXwordBranchOff(); err != nil {
-            err = excError{exc: excIllegalInstr}
+        if v, xwordErr := ctx.decodeXwordBranchOff(); xwordErr != nil {
+            err = xwordErr
             return
         }else {
             resTemp.branchOff = v
@@ -262,6 +3718,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrBsr{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "bsr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xff00) != 0x6100 {
             err = excError{exc: excIllegalInstr}
             return
@@ -270,8 +3729,8 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
             err = excError{exc: excIllegalInstr}
             return
         }
-        if v, err := ctx.decodeXwordBranchOff(); err != nil {
-            err = excError{exc: excIllegalInstr}
+        if v, xwordErr := ctx.decodeXwordBranchOff(); xwordErr != nil {
+            err = xwordErr
             return
         }else {
             resTemp.branchOff = v
@@ -289,6 +3748,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrBcc{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "bcc"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xf000) != 0x6000 {
             err = excError{exc: excIllegalInstr}
             return
@@ -303,8 +3765,8 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
             err = excError{exc: excIllegalInstr}
             return
         }
-        if v, err := ctx.decodeXwordBranchOff(); err != nil {
-            err = excError{exc: excIllegalInstr}
+        if v, xwordErr := ctx.decodeXwordBranchOff(); xwordErr != nil {
+            err = xwordErr
             return
         }else {
             resTemp.branchOff = v
@@ -322,6 +3784,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrDbcc{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "dbcc"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xf0f8) != 0x50c8 {
             err = excError{exc: excIllegalInstr}
             return
@@ -342,8 +3807,8 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
             err = excError{exc: excIllegalInstr}
             return
         }
-        if v, err := ctx.decodeXwordImm16(); err != nil {
-            err = excError{exc: excIllegalInstr}
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
             return
         }else {
             resTemp.imm16 = v
@@ -356,11 +3821,98 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
     if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
         return
     }
+    // instrMovemToMem
+    func() {
+        err = nil
+        resTemp := instrMovemToMem{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movemtomem"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff80) != 0x4880 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType3(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeAregInd, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordRegList(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.regList = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
+    // instrMovemToReg
+    func() {
+        err = nil
+        resTemp := instrMovemToReg{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movemtoreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xff80) != 0x4c80 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldSizeType3(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.size = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordRegList(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.regList = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
     // instrLea
     func() {
         err = nil
         resTemp := instrLea{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "lea"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xf1c0) != 0x41c0 {
             err = excError{exc: excIllegalInstr}
             return
@@ -394,6 +3946,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrPea{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "pea"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffc0) != 0x4840 {
             err = excError{exc: excIllegalInstr}
             return
@@ -421,6 +3976,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrJmp{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "jmp"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffc0) != 0x4ec0 {
             err = excError{exc: excIllegalInstr}
             return
@@ -448,6 +4006,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrJsr{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "jsr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffc0) != 0x4e80 {
             err = excError{exc: excIllegalInstr}
             return
@@ -475,6 +4036,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrLink{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "link"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xfff8) != 0x4e50 {
             err = excError{exc: excIllegalInstr}
             return
@@ -489,8 +4053,8 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
             err = excError{exc: excIllegalInstr}
             return
         }
-        if v, err := ctx.decodeXwordImm16(); err != nil {
-            err = excError{exc: excIllegalInstr}
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
             return
         }else {
             resTemp.imm16 = v
@@ -508,6 +4072,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrUnlk{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "unlk"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xfff8) != 0x4e58 {
             err = excError{exc: excIllegalInstr}
             return
@@ -535,6 +4102,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrSwap{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "swap"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xfff8) != 0x4840 {
             err = excError{exc: excIllegalInstr}
             return
@@ -562,6 +4132,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrMoveToUsp{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movetousp"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xfff8) != 0x4e60 {
             err = excError{exc: excIllegalInstr}
             return
@@ -589,6 +4162,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrMoveFromUsp{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "movefromusp"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xfff8) != 0x4e68 {
             err = excError{exc: excIllegalInstr}
             return
@@ -616,6 +4192,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrExtW{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "extw"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xfff8) != 0x4880 {
             err = excError{exc: excIllegalInstr}
             return
@@ -643,6 +4222,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrExtL{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "extl"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xfff8) != 0x48c0 {
             err = excError{exc: excIllegalInstr}
             return
@@ -665,11 +4247,50 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
     if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
         return
     }
+    // instrChk
+    func() {
+        err = nil
+        resTemp := instrChk{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "chk"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeWord
+        if (ctx.decodingCtx.ir & 0xf1c0) != 0x4180 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, ok := ctx.decodeFieldRegX(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.regX = v
+        }
+        if v, ok := ctx.decodeFieldEa1(); !ok {
+            err = excError{exc: excIllegalInstr}
+            return
+        }else {
+            resTemp.ea1 = v
+        }
+        if !ctx.checkEaModes([]eamode{eamodeDreg, eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex, eamodeImm}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
     // instrTrap
     func() {
         err = nil
         resTemp := instrTrap{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "trap"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xfff0) != 0x4e40 {
             err = excError{exc: excIllegalInstr}
             return
@@ -697,6 +4318,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrTrapV{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "trapv"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffff) != 0x4e76 {
             err = excError{exc: excIllegalInstr}
             return
@@ -718,6 +4342,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrExgDReg{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "exgdreg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xf1f8) != 0xc140 {
             err = excError{exc: excIllegalInstr}
             return
@@ -751,6 +4378,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrExgAReg{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "exgareg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xf1f8) != 0xc148 {
             err = excError{exc: excIllegalInstr}
             return
@@ -784,6 +4414,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrExgDAReg{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "exgdareg"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xf1f8) != 0xc188 {
             err = excError{exc: excIllegalInstr}
             return
@@ -812,11 +4445,44 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
     if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
         return
     }
+    // instrStop
+    func() {
+        err = nil
+        resTemp := instrStop{}
+        resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "stop"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
+        if (ctx.decodingCtx.ir & 0xffff) != 0x4e72 {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if !ctx.checkEaModes([]eamode{}, []eamode{}) {
+            err = excError{exc: excIllegalInstr}
+            return
+        }
+        if v, xwordErr := ctx.decodeXwordImm16(); xwordErr != nil {
+            err = xwordErr
+            return
+        }else {
+            resTemp.imm16 = v
+        }
+        if err = ctx.decodeEa(); err != nil {
+            return
+        }
+        res = resTemp
+    }()
+    if excErr, isExcErr := err.(excError); !isExcErr || (isExcErr && (excErr.exc != excIllegalInstr)) {
+        return
+    }
     // instrIllegal
     func() {
         err = nil
         resTemp := instrIllegal{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "illegal"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffff) != 0x4afc {
             err = excError{exc: excIllegalInstr}
             return
@@ -838,6 +4504,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrNop{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "nop"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffff) != 0x4e71 {
             err = excError{exc: excIllegalInstr}
             return
@@ -859,6 +4528,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrRts{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "rts"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffff) != 0x4e75 {
             err = excError{exc: excIllegalInstr}
             return
@@ -880,6 +4552,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrRtr{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "rtr"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffff) != 0x4e77 {
             err = excError{exc: excIllegalInstr}
             return
@@ -901,6 +4576,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrReset{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "reset"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffff) != 0x4e70 {
             err = excError{exc: excIllegalInstr}
             return
@@ -922,6 +4600,9 @@ func (ctx *clientContext) instrDecode() (res instr, err error) {
         err = nil
         resTemp := instrRte{}
         resTemp.instrPc = ctx.pc - 2
+        ctx.decodingCtx.currInstrName = "rte"
+        ctx.decodingCtx.eaFields = [2]*ea{}
+        ctx.decodingCtx.opsize = opsizeNone
         if (ctx.decodingCtx.ir & 0xffff) != 0x4e73 {
             err = excError{exc: excIllegalInstr}
             return
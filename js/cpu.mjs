@@ -8,19 +8,54 @@ export default class CPUClient {
     #sentBytesSum = 0;
     #recvBytesSum = 0;
     #connStartTime = undefined;
+    // Interrupt level of the last asserted CPU space address, if any
+    #intAckLevel = undefined;
 
     static DS_UPPER = 1 << 0; // UDS=1 LDS=0; Only upper 8-bit of 16-bit data bus is active
     static DS_LOWER = 1 << 1; // UDS=0 LDS=1; Only lower 8-bit of 16-bit data bus is active
     static DS_BOTH = CPUClient.DS_UPPER | CPUClient.DS_LOWER; // UDS=1 LDS=1; All of 16-bit data bus is active
 
+    // Function codes(FC0~FC2)
+    static FC_USER_DATA = 1;
+    static FC_USER_PROGRAM = 2;
+    static FC_SUPER_DATA = 5;
+    static FC_SUPER_PROGRAM = 6;
+    static FC_CPU_SPACE = 7;
+
+    // Bus direction(R/W)
+    static BUS_READ = 0;
+    static BUS_WRITE = 1;
+
+    // Flags for run()
+    static RUN_UNTIL_PC = 1 << 0; // Stop when PC reaches untilPc
+    static RUN_UNTIL_EXC = 1 << 1; // Stop when exception processing happens
+
+    // Reasons returned by run()
+    static RUN_REASON_COUNT = 0; // Ran maxCount ticks
+    static RUN_REASON_PC = 1; // PC reached untilPc
+    static RUN_REASON_STOPPED = 2; // CPU is stopped
+    static RUN_REASON_HALTED = 3; // CPU is halted
+    static RUN_REASON_EXC = 4; // Exception processing happened
+    static RUN_REASON_FAILED = 5; // Exception processing failed
+
+    // Bus modes for setBusMode()
+    static BUS_MODE_TWO_PHASE = 0; // EVENT_ADDR_ASSERTED, then EVENT_READ/WRITE_BUS
+    static BUS_MODE_COMBINED = 1; // Single EVENT_BUS_CYCLE
+
     static CCR_FLAG_C = 1 << 0;
     static CCR_FLAG_V = 1 << 1;
     static CCR_FLAG_Z = 1 << 2;
     static CCR_FLAG_N = 1 << 3;
     static CCR_FLAG_X = 1 << 4;
 
-    // Takes address, and returns boolean indicating whether a valid device is there or not.
-    onAddressAsserted = (_addr) => {
+    // Special return values for onInterruptAck
+    static INT_AUTOVECTOR = -1; // Use autovector (VPA asserted)
+    static INT_BUS_ERROR = -2; // Bus error; CPU will take spurious interrupt
+
+    // Takes address, function code, bus direction and data strobes(DS_*), and
+    // returns boolean indicating whether a valid device is there or not.
+    // If there is none, the CPU takes bus error exception.
+    onAddressAsserted = (_addr, _fc, _dir, _ds) => {
         throw new Error('not implemented');
     };
     // Reads from the last asserted address, and returns the result.
@@ -35,6 +70,15 @@ export default class CPUClient {
     onResetAsserted = () => {
         throw new Error('not implemented');
     };
+    // CPU was halted due to double fault
+    onHalted = () => {
+        throw new Error('not implemented');
+    };
+    // Interrupt acknowledge cycle(CPU space read with FC=7) for given level.
+    // Returns vector number, CPUClient.INT_AUTOVECTOR, or CPUClient.INT_BUS_ERROR.
+    onInterruptAck = (_level) => {
+        throw new Error('not implemented');
+    };
     // Called if execution tracing is enabled
     onTraceExec = (_pc, _ir, _disasm) => {
         throw new Error('not implemented');
@@ -118,13 +162,18 @@ export default class CPUClient {
                     }
                     // EVENT_ADDR_ASSERTED -------------------------------------
                     case NETOP.EVENT_ADDR_ASSERTED: {
-                        const res = this.#takeMsg('l');
+                        const res = this.#takeMsg('lbbb');
                         if (res === undefined) {
                             // Try again next time
                             return;
                         }
-                        const [addr] = res;
-                        if (!this.onAddressAsserted(addr)) {
+                        const [addr, fc, dir, ds] = res;
+                        this.#intAckLevel = undefined;
+                        if (fc === CPUClient.FC_CPU_SPACE) {
+                            // Interrupt acknowledge. Actual reply is sent on EVENT_READ_BUS.
+                            this.#intAckLevel = (addr >> 1) & 0x7;
+                            this.#client.write(new Uint8Array([NETOP.ACK]));
+                        } else if (!this.onAddressAsserted(addr, fc, dir, ds)) {
                             this.#client.write(new Uint8Array([NETOP.FAIL]));
                         } else {
                             this.#client.write(new Uint8Array([NETOP.ACK]));
@@ -139,6 +188,12 @@ export default class CPUClient {
                             return;
                         }
                         const [ds] = res;
+                        if (this.#intAckLevel !== undefined) {
+                            this.#client.write(
+                                this.#intAckResponse(this.#intAckLevel)
+                            );
+                            break;
+                        }
                         const val = this.onBusRead(ds);
                         this.#client.write(
                             new Uint8Array([NETOP.ACK, ...makeW(val)])
@@ -157,6 +212,31 @@ export default class CPUClient {
                         this.#client.write(new Uint8Array([NETOP.ACK]));
                         break;
                     }
+                    // EVENT_BUS_CYCLE -----------------------------------------
+                    case NETOP.EVENT_BUS_CYCLE: {
+                        const res = this.#takeMsg('lbbbw');
+                        if (res === undefined) {
+                            // Try again next time
+                            return;
+                        }
+                        const [addr, fc, dir, ds, val] = res;
+                        if (fc === CPUClient.FC_CPU_SPACE) {
+                            this.#client.write(
+                                this.#intAckResponse((addr >> 1) & 0x7)
+                            );
+                        } else if (!this.onAddressAsserted(addr, fc, dir, ds)) {
+                            this.#client.write(new Uint8Array([NETOP.FAIL]));
+                        } else if (dir === CPUClient.BUS_READ) {
+                            const readVal = this.onBusRead(ds);
+                            this.#client.write(
+                                new Uint8Array([NETOP.ACK, ...makeW(readVal)])
+                            );
+                        } else {
+                            this.onBusWrite(ds, val);
+                            this.#client.write(new Uint8Array([NETOP.ACK]));
+                        }
+                        break;
+                    }
                     // EVENT_RESET ---------------------------------------------
                     case NETOP.EVENT_RESET: {
                         const res = this.#takeMsg('');
@@ -204,6 +284,17 @@ export default class CPUClient {
                         this.#client.write(new Uint8Array([NETOP.ACK]));
                         break;
                     }
+                    // EVENT_HALT ----------------------------------------------
+                    case NETOP.EVENT_HALT: {
+                        const res = this.#takeMsg('');
+                        if (res === undefined) {
+                            // Try again next time
+                            return;
+                        }
+                        this.onHalted();
+                        this.#client.write(new Uint8Array([NETOP.ACK]));
+                        break;
+                    }
                     default: {
                         throw Error(`Unrecognized opbyte ${tp.toString(16)}`);
                     }
@@ -236,11 +327,83 @@ export default class CPUClient {
         return this.#sendCmd(cmd, '');
     }
 
+    async setIpl(level) {
+        const cmd = [NETOP.SET_IPL, level];
+        return this.#sendCmd(cmd, '');
+    }
+
+    async reset() {
+        const cmd = [NETOP.RESET];
+        return this.#sendCmd(cmd, '');
+    }
+
+    async setBusMode(mode) {
+        const cmd = [NETOP.SET_BUS_MODE, mode];
+        return this.#sendCmd(cmd, '');
+    }
+
+    async isHalted() {
+        const cmd = [NETOP.IS_HALTED];
+        return (await this.#sendCmd(cmd, 'b'))[0] === 1;
+    }
+
+    // Does not reset the CPU: PC, SR and SSP must be restored by the caller (or use reset() instead).
+    async unhalt() {
+        const cmd = [NETOP.UNHALT];
+        return this.#sendCmd(cmd, '');
+    }
+
     async tick() {
         const cmd = [NETOP.TICK];
         return this.#sendCmd(cmd, '');
     }
 
+    // Runs up to maxCount ticks, and returns [count, reason].
+    // See RUN_* for flags and reasons.
+    async run(maxCount, flags = 0, untilPc = 0) {
+        const cmd = [
+            NETOP.RUN,
+            ...makeL(maxCount),
+            flags,
+            ...makeL(untilPc),
+        ];
+        return this.#sendCmd(cmd, 'lb');
+    }
+
+    // Maps RAM/ROM region held by the server. Accesses to these regions
+    // don't generate bus events.
+    async mapRam(start, size) {
+        const cmd = [NETOP.MAP_RAM, ...makeL(start), ...makeL(size)];
+        return this.#sendCmd(cmd, '');
+    }
+
+    async mapRom(start, size) {
+        const cmd = [NETOP.MAP_ROM, ...makeL(start), ...makeL(size)];
+        return this.#sendCmd(cmd, '');
+    }
+
+    async unmapAll() {
+        const cmd = [NETOP.UNMAP_ALL];
+        return this.#sendCmd(cmd, '');
+    }
+
+    // Writes bytes to RAM/ROM region. The range must be within single region.
+    async uploadMem(addr, bytes) {
+        const cmd = [
+            NETOP.MEM_UPLOAD,
+            ...makeL(addr),
+            ...makeL(bytes.length),
+            ...bytes,
+        ];
+        return this.#sendCmd(cmd, '');
+    }
+
+    // Reads bytes from RAM/ROM region. The range must be within single region.
+    async downloadMem(addr, len) {
+        const cmd = [NETOP.MEM_DOWNLOAD, ...makeL(addr), ...makeL(len)];
+        return (await this.#sendCmd(cmd, 'B'))[0];
+    }
+
     async writeDreg(reg, val) {
         const cmd = [NETOP.WRITE_DREG, reg, ...makeL(val)];
         return this.#sendCmd(cmd, '');
@@ -301,6 +464,16 @@ export default class CPUClient {
         return (await this.#sendCmd(cmd, 'w'))[0];
     }
 
+    #intAckResponse(level) {
+        const vector = this.onInterruptAck(level);
+        if (vector === CPUClient.INT_BUS_ERROR) {
+            return new Uint8Array([NETOP.FAIL]);
+        } else if (vector === CPUClient.INT_AUTOVECTOR) {
+            return new Uint8Array([NETOP.AUTOVECTOR]);
+        }
+        return new Uint8Array([NETOP.ACK, ...makeW(vector)]);
+    }
+
     #sendCmd(cmd, fmt) {
         cmd.forEach((e) => {
             if (typeof e !== 'number') {
@@ -369,6 +542,19 @@ export default class CPUClient {
                     needed_len += 1 + len;
                     break;
                 }
+                // Byte array with 32-bit length prefix
+                case 'B': {
+                    if (this.#inboxBuf.length < needed_len + 4) {
+                        return;
+                    }
+                    const len =
+                        (this.#inboxBuf[needed_len] << 24) |
+                        (this.#inboxBuf[needed_len + 1] << 16) |
+                        (this.#inboxBuf[needed_len + 2] << 8) |
+                        this.#inboxBuf[needed_len + 3];
+                    needed_len += 4 + len;
+                    break;
+                }
                 default:
                     console.error(`Unrecognized format char ${fmt[i]}`);
                     break;
@@ -420,6 +606,18 @@ export default class CPUClient {
                     results.push(tdec.decode(bytes));
                     break;
                 }
+                case 'B': {
+                    const lenBytes = this.#inboxBuf.splice(0, 4);
+                    const len =
+                        (lenBytes[0] << 24) |
+                        (lenBytes[1] << 16) |
+                        (lenBytes[2] << 8) |
+                        lenBytes[3];
+                    results.push(
+                        new Uint8Array(this.#inboxBuf.splice(0, len))
+                    );
+                    break;
+                }
                 default:
                     console.error(`Unrecognized format char ${fmt[i]}`);
                     break;
@@ -439,6 +637,7 @@ function makeL(v) {
 const NETOP = {
     ACK: 0x00,
     FAIL: 0x01,
+    AUTOVECTOR: 0x02, // Only for interrupt acknowledge
 
     BYE: 0x10,
     UNSTOP: 0x11,
@@ -447,6 +646,12 @@ const NETOP = {
     TRACE_EXEC_OFF: 0x14,
     TRACE_EXC_ON: 0x15,
     TRACE_EXC_OFF: 0x16,
+    SET_IPL: 0x17,
+    RESET: 0x18,
+    IS_HALTED: 0x19,
+    UNHALT: 0x1a,
+    SET_BUS_MODE: 0x1b,
+    RUN: 0x1c,
     TICK: 0x1f,
 
     WRITE_DREG: 0x20,
@@ -461,6 +666,11 @@ const NETOP = {
     READ_PC: 0x29,
     WRITE_SR: 0x2a,
     READ_SR: 0x2b,
+    MAP_RAM: 0x30,
+    MAP_ROM: 0x31,
+    UNMAP_ALL: 0x32,
+    MEM_UPLOAD: 0x33,
+    MEM_DOWNLOAD: 0x34,
 
     EVENT_ADDR_ASSERTED: 0x80,
     EVENT_READ_BUS: 0x81,
@@ -469,4 +679,6 @@ const NETOP = {
     EVENT_TRACE_EXEC: 0x84,
     EVENT_TRACE_EXC: 0x85,
     EVENT_TRACE_EXC_MEM: 0x86,
+    EVENT_HALT: 0x88,
+    EVENT_BUS_CYCLE: 0x89,
 };
@@ -64,6 +64,9 @@ cpu.onAddressAsserted = (addr) => {
 cpu.onResetAsserted = () => {
     execLogs.push(`RESET |`);
 };
+cpu.onHalted = () => {
+    execLogs.push(` HALT |`);
+};
 cpu.onBusWrite = (ds, val) => {
     execLogs.push(
         `  BUS | W addr=${hex(assertedRamAddr)} ds=${ds} val=${hex(val)}`
@@ -199,20 +202,25 @@ async function runTestFile(filename, fileText) {
                 failed = true;
                 break;
             }
+            let reason;
             try {
-                await cpu.tick();
+                [, reason] = await cpu.run(
+                    1000,
+                    CPUClient.RUN_UNTIL_PC,
+                    finalPc
+                );
             } catch (e) {
                 console.log('>>> CPU Error');
                 console.log(e);
                 failed = true;
                 break;
             }
-            const isStopped = await cpu.isStopped();
-            if (isStopped) {
+            if (reason === CPUClient.RUN_REASON_FAILED) {
+                console.log('>>> CPU Error (Exception processing failed)');
+                failed = true;
                 break;
             }
-            const pc = await cpu.readPc();
-            if (pc === finalPc) {
+            if (reason !== CPUClient.RUN_REASON_COUNT) {
                 break;
             }
         }
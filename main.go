@@ -4,11 +4,19 @@ package main
 import (
 	"bufio"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math/bits"
 	"net"
+	"os"
+	"os/signal"
+	"runtime/debug"
 	"slices"
+	"strings"
+	"sync"
+	"syscall"
 )
 
 //go:generate go run ./tool_autogen/ instr_autogen.go
@@ -20,21 +28,58 @@ func main() {
 		log.Fatalf("Failed to listen to connection -- %v", err)
 	}
 	log.Printf("Started server at %s", addr)
+
+	// Stop accepting new connections on SIGINT/SIGTERM. The accept loop below sees the closed listener and begins shutdown.
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-sigChan
+		log.Printf("Received %v, shutting down the server", sig)
+		listener.Close()
+	}()
+
+	// Each client gets its own CPU, running on its own goroutine.
+	var wg sync.WaitGroup
+	var connsMutex sync.Mutex
+	conns := map[net.Conn]struct{}{}
+	sessionId := 0
 	for {
 		conn, err := listener.Accept()
 		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				break
+			}
 			log.Printf("Failed to accept to connection -- %v", err)
 			continue
 		}
-		log.Printf("New client connection from %s", conn.RemoteAddr().String())
-		clientCtx := clientContext{
-			conn:   conn,
-			reader: bufio.NewReader(conn),
-		}
-		clientCtx.main()
-		conn.Close()
+		sessionId++
+		log.Printf("New client connection from %s (session %d)", conn.RemoteAddr().String(), sessionId)
+		connsMutex.Lock()
+		conns[conn] = struct{}{}
+		connsMutex.Unlock()
+		wg.Add(1)
+		go func(sessionId int) {
+			defer wg.Done()
+			clientCtx := clientContext{
+				sessionId: sessionId,
+				conn:      conn,
+				reader:    bufio.NewReader(conn),
+			}
+			clientCtx.main()
+			connsMutex.Lock()
+			delete(conns, conn)
+			connsMutex.Unlock()
+		}(sessionId)
 	}
 
+	// Close remaining connections, so that sessions stop waiting for the next command.
+	connsMutex.Lock()
+	for conn := range conns {
+		conn.Close()
+	}
+	connsMutex.Unlock()
+	wg.Wait()
+	log.Printf("Server stopped")
 }
 
 //==============================================================================
@@ -55,9 +100,11 @@ type clientContext struct {
 	lastExecutedIr uint16
 
 	// Networking --------------------------------------------------------------
-	conn   net.Conn
-	reader *bufio.Reader
-	closed bool
+	sessionId int // Only used for logging
+	conn      net.Conn
+	reader    *bufio.Reader
+	closed    bool
+	busMode   netBusMode // How bus cycles that are not handled by the server are sent to the client
 
 	// Registers ---------------------------------------------------------------
 	dataRegs [8]uint32
@@ -80,10 +127,18 @@ type clientContext struct {
 	// Other flags -------------------------------------------------------------
 	// "Trace" flags below control whether the trace event is sent to the client or not.
 	// These are not related to 68000's tracing feature.
-	traceExec      bool
-	traceExc       bool
-	stopped        bool
-	inGroup0Or1Exc bool
+	traceExec       bool
+	traceExc        bool
+	stopped         bool
+	halted          bool // Set on double fault. Only reset(or client) can bring the CPU out of this state.
+	inExcProcessing bool // Set while processing an exception (I/N bit of bus/address error stack frame)
+
+	// Interrupts --------------------------------------------------------------
+	ipl        uint8 // Current level of IPL0~IPL2 input
+	nmiPending bool  // Level 7 interrupt is edge-triggered, so we remember when IPL goes to 7.
+
+	// Memory ------------------------------------------------------------------
+	memRegions []memRegion // RAM/ROM regions held by the server. Accesses outside of these go to the client.
 }
 
 //==============================================================================
@@ -99,6 +154,44 @@ const (
 	opsizeLong
 )
 
+func (size opsize) ToString() string {
+	switch size {
+	case opsizeByte:
+		return "b"
+	case opsizeWord:
+		return "w"
+	case opsizeLong:
+		return "l"
+	}
+	panic("bad opsize")
+}
+
+// Returns mask covering all bits of given size.
+func (size opsize) mask() uint32 {
+	switch size {
+	case opsizeByte:
+		return 0xff
+	case opsizeWord:
+		return 0xffff
+	case opsizeLong:
+		return 0xffffffff
+	}
+	panic("bad opsize")
+}
+
+// Returns mask for the sign bit of given size.
+func (size opsize) msb() uint32 {
+	switch size {
+	case opsizeByte:
+		return 0x80
+	case opsizeWord:
+		return 0x8000
+	case opsizeLong:
+		return 0x80000000
+	}
+	panic("bad opsize")
+}
+
 //==============================================================================
 // SR/CCR
 //==============================================================================
@@ -200,6 +293,122 @@ func (ctx *clientContext) clearVCFlags() {
 	ctx.ccrC = false
 }
 
+// Calculates dest + src and sets all flags accordingly.
+//
+// If withX is true, X flag is added to the result as well(ADDX), and Z flag is only cleared if the result is nonzero.
+// This is so that multi-precision operations set the Z flag correctly.
+func (ctx *clientContext) addAndSetFlags(dest uint32, src uint32, size opsize, withX bool) uint32 {
+	mask := size.mask()
+	msb := size.msb()
+	dest &= mask
+	src &= mask
+	res64 := uint64(dest) + uint64(src)
+	if withX && ctx.ccrX {
+		res64++
+	}
+	res := uint32(res64) & mask
+	ctx.ccrC = uint64(mask) < res64
+	ctx.ccrX = ctx.ccrC
+	// Overflow happens when both operands have same sign, but the result doesn't.
+	ctx.ccrV = (^(dest ^ src) & (dest ^ res) & msb) != 0
+	ctx.ccrN = (res & msb) != 0
+	if !withX {
+		ctx.ccrZ = res == 0
+	} else if res != 0 {
+		ctx.ccrZ = false
+	}
+	return res
+}
+
+// Calculates dest + src + X in packed BCD, and sets flags accordingly.
+//
+// This also matches what real 68000 does with invalid BCD values and undefined N, V flags:
+// The CPU first does binary addition, then adds correction factor(6 for each digit that carried or went above 9).
+// V is set when the correction changes bit 7 from 0 to 1, and N is simply bit 7 of the result.
+func (ctx *clientContext) abcdAndSetFlags(dest uint8, src uint8) uint8 {
+	x := uint8(0)
+	if ctx.ccrX {
+		x = 1
+	}
+	binRes := dest + src + x
+	// Binary carry out of each digit
+	binCarry := ((dest & src) | (^binRes & dest) | (^binRes & src)) & 0x88
+	// Decimal carry out of each digit(i.e. Digit went above 9)
+	decCarry := uint8((((uint16(binRes) + 0x66) ^ uint16(binRes)) & 0x110) >> 1)
+	corr := (binCarry | decCarry) - ((binCarry | decCarry) >> 2)
+	res := binRes + corr
+	ctx.ccrC = ((binCarry | (binRes & ^res)) & 0x80) != 0
+	ctx.ccrX = ctx.ccrC
+	ctx.ccrV = (^binRes & res & 0x80) != 0
+	ctx.ccrN = (res & 0x80) != 0
+	if res != 0 {
+		ctx.ccrZ = false
+	}
+	return res
+}
+
+// Calculates dest - src - X in packed BCD, and sets flags accordingly.
+// See abcdAndSetFlags for notes about invalid BCD values and undefined flags.
+func (ctx *clientContext) sbcdAndSetFlags(dest uint8, src uint8) uint8 {
+	x := uint8(0)
+	if ctx.ccrX {
+		x = 1
+	}
+	binRes := dest - src - x
+	// Binary borrow out of each digit
+	binBorrow := ((^dest & src) | (binRes & ^dest) | (binRes & src)) & 0x88
+	corr := binBorrow - (binBorrow >> 2)
+	res := binRes - corr
+	ctx.ccrC = ((binBorrow | (^binRes & res)) & 0x80) != 0
+	ctx.ccrX = ctx.ccrC
+	ctx.ccrV = (binRes & ^res & 0x80) != 0
+	ctx.ccrN = (res & 0x80) != 0
+	if res != 0 {
+		ctx.ccrZ = false
+	}
+	return res
+}
+
+// Sets flags for result of logical operations(AND, OR, EOR, NOT), and returns the result.
+func (ctx *clientContext) logicalSetFlags(res uint32, size opsize) uint32 {
+	res &= size.mask()
+	ctx.setNZFlags(res, size)
+	ctx.clearVCFlags()
+	return res
+}
+
+// Compares dest with src(dest - src) and sets flags accordingly. Unlike SUB, X flag is not affected.
+func (ctx *clientContext) compareAndSetFlags(dest uint32, src uint32, size opsize) {
+	oldX := ctx.ccrX
+	ctx.subAndSetFlags(dest, src, size, false)
+	ctx.ccrX = oldX
+}
+
+// Calculates dest - src and sets all flags accordingly.
+// See addAndSetFlags for how withX works.
+func (ctx *clientContext) subAndSetFlags(dest uint32, src uint32, size opsize, withX bool) uint32 {
+	mask := size.mask()
+	msb := size.msb()
+	dest &= mask
+	src &= mask
+	sub64 := uint64(src)
+	if withX && ctx.ccrX {
+		sub64++
+	}
+	res := uint32(uint64(dest)-sub64) & mask
+	ctx.ccrC = uint64(dest) < sub64
+	ctx.ccrX = ctx.ccrC
+	// Overflow happens when operands have different sign, and the result's sign is different from dest.
+	ctx.ccrV = ((dest ^ src) & (dest ^ res) & msb) != 0
+	ctx.ccrN = (res & msb) != 0
+	if !withX {
+		ctx.ccrZ = res == 0
+	} else if res != 0 {
+		ctx.ccrZ = false
+	}
+	return res
+}
+
 // ==============================================================================
 // Data and address registers
 // ==============================================================================
@@ -369,6 +578,10 @@ type ea struct {
 	indexRegType regType
 	indexSize    opsize
 	indexReg     uint8
+
+	// PC after extension words of this operand were fetched.
+	// 68000 fetches extension words of each operand right before accessing it, so this is the PC stacked by bus/address errors on the operand.
+	xwordEndPc uint32
 }
 
 func (ea ea) reg() uint8 {
@@ -419,13 +632,13 @@ func (ea ea) ToString() string {
 	case eamodeAregIndPredec:
 		return fmt.Sprintf("-(a%d)", ea.reg())
 	case eamodeAregIndDisp:
-		return fmt.Sprintf("(%d, a%d)", ea.disp(), ea.reg())
+		return fmt.Sprintf("(%d, a%d)", int32(ea.disp()), ea.reg())
 	case eamodeAregIndIndex:
-		return fmt.Sprintf("(%d, a%d, %s%d)", ea.disp(), ea.reg(), ea.indexRegType.ToString(), ea.indexReg)
+		return fmt.Sprintf("(%d, a%d, %s%d)", int32(ea.disp()), ea.reg(), ea.indexRegType.ToString(), ea.indexReg)
 	case eamodePcIndDisp:
-		return fmt.Sprintf("(%d, pc)", ea.disp())
+		return fmt.Sprintf("(%d, pc)", int32(ea.disp()))
 	case eamodePcIndIndex:
-		return fmt.Sprintf("(%d, pc, %s%d)", ea.disp(), ea.indexRegType.ToString(), ea.indexReg)
+		return fmt.Sprintf("(%d, pc, %s%d)", int32(ea.disp()), ea.indexRegType.ToString(), ea.indexReg)
 	case eamodeAbsW, eamodeAbsL:
 		return fmt.Sprintf("$%08X", ea.absAddr())
 	case eamodeImm:
@@ -499,16 +712,21 @@ func (ctx *clientContext) readEa(ea ea, size opsize) (uint32, error) {
 	case eamodeDreg:
 		return ctx.readDreg(ea.reg(), size), nil
 	case eamodeAreg:
-		if size != opsizeLong {
-			panic("An mode can only be read with long size")
+		switch size {
+		case opsizeWord:
+			return uint32(uint16(ctx.readAreg(ea.reg()))), nil
+		case opsizeLong:
+			return ctx.readAreg(ea.reg()), nil
+		default:
+			panic("An mode can only be read with word or long size")
 		}
-		return ctx.readAreg(ea.reg()), nil
 	case eamodeImm:
 		return ea.imm(), nil
 	case eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL, eamodePcIndDisp, eamodePcIndIndex:
 		addr := ctx.memAddrOfEa(ea, size)
 		fc := ctx.getFuncCode(false)
-		return ctx.readMem(addr, fc, size)
+		v, err := ctx.readMem(addr, fc, size)
+		return v, ea.operandExcError(err)
 	}
 	panic("bad eamode")
 }
@@ -526,7 +744,7 @@ func (ctx *clientContext) writeEa(ea ea, size opsize, v uint32) error {
 	case eamodeAregInd, eamodeAregIndPostinc, eamodeAregIndPredec, eamodeAregIndDisp, eamodeAregIndIndex, eamodeAbsW, eamodeAbsL:
 		addr := ctx.memAddrOfEa(ea, size)
 		fc := ctx.getFuncCode(false)
-		return ctx.writeMem(addr, fc, size, v)
+		return ea.operandExcError(ctx.writeMem(addr, fc, size, v))
 	}
 	panic("bad eamode")
 }
@@ -553,13 +771,24 @@ func (ctx *clientContext) readModifyWriteEa(ea ea, size opsize, modify func(uint
 		fc := ctx.getFuncCode(false)
 		v, err := ctx.readMem(addr, fc, size)
 		if err != nil {
-			return err
+			return ea.operandExcError(err)
 		}
-		return ctx.writeMem(addr, fc, size, v)
+		v = modify(v)
+		return ea.operandExcError(ctx.writeMem(addr, fc, size, v))
 	}
 	panic("bad eamode")
 }
 
+// Sets stacked PC of bus/address error that occured while accessing the operand.
+// Other errors are returned as-is.
+func (ea ea) operandExcError(err error) error {
+	if excErr, isExcErr := err.(excError); isExcErr && excErr.isMemExc() {
+		excErr.pc = ea.xwordEndPc
+		return excErr
+	}
+	return err
+}
+
 //==============================================================================
 // Conditions
 //==============================================================================
@@ -651,6 +880,102 @@ func (ctx *clientContext) testCond(cond cond) bool {
 	panic("bad cond value")
 }
 
+//==============================================================================
+// Shift and rotate
+//==============================================================================
+
+// WARNING: These values also correspond to fields in instructions!
+// DO NOT CHANGE WITHOUT A REASON.
+type shiftKind uint8
+
+const (
+	shiftKindAs  = shiftKind(0x0) // Arithmetic shift
+	shiftKindLs  = shiftKind(0x1) // Logical shift
+	shiftKindRox = shiftKind(0x2) // Rotate with extend
+	shiftKindRo  = shiftKind(0x3) // Rotate
+)
+
+type shiftDir uint8
+
+const (
+	shiftDirRight = shiftDir(0x0)
+	shiftDirLeft  = shiftDir(0x1)
+)
+
+func shiftToString(kind shiftKind, dir shiftDir) string {
+	kindStrs := [4]string{"as", "ls", "rox", "ro"}
+	if dir == shiftDirLeft {
+		return kindStrs[kind] + "l"
+	}
+	return kindStrs[kind] + "r"
+}
+
+// Shifts or rotates v by count bits and sets flags accordingly.
+//
+// Shifting is done one bit at a time, which is the simplest way to get flags right(Especially V flag of ASL, which
+// is set if MSB changes at any point during the shift).
+func (ctx *clientContext) shiftAndSetFlags(kind shiftKind, dir shiftDir, v uint32, count uint32, size opsize) uint32 {
+	mask := size.mask()
+	msb := size.msb()
+	v &= mask
+	x := ctx.ccrX
+	lastOut := false
+	overflow := false
+	for range count {
+		if dir == shiftDirLeft {
+			lastOut = (v & msb) != 0
+			newV := (v << 1) & mask
+			switch kind {
+			case shiftKindAs:
+				if (newV & msb) != (v & msb) {
+					overflow = true
+				}
+			case shiftKindRox:
+				if x {
+					newV |= 1
+				}
+			case shiftKindRo:
+				if lastOut {
+					newV |= 1
+				}
+			}
+			v = newV
+		} else {
+			lastOut = (v & 0x1) != 0
+			newV := v >> 1
+			switch kind {
+			case shiftKindAs:
+				newV |= v & msb
+			case shiftKindRox:
+				if x {
+					newV |= msb
+				}
+			case shiftKindRo:
+				if lastOut {
+					newV |= msb
+				}
+			}
+			v = newV
+		}
+		if kind == shiftKindRox {
+			x = lastOut
+		}
+	}
+	if count == 0 {
+		// X is not affected, and C is cleared, except for ROXL/ROXR where C is set to X.
+		ctx.ccrC = (kind == shiftKindRox) && ctx.ccrX
+	} else {
+		ctx.ccrC = lastOut
+		// Everything except ROL/ROR sets X as well.
+		if kind != shiftKindRo {
+			ctx.ccrX = lastOut
+		}
+	}
+	ctx.ccrV = overflow
+	ctx.setNZFlags(v, size)
+	return v
+}
+
 //==============================================================================
 // Instruction interface
 //==============================================================================
@@ -687,17 +1012,20 @@ func (ctx *clientContext) fetchInstrL() (uint32, error) {
 //
 // I could've made those take directly from the IR, but then golang formatter decides it's a bit too long and breaks into multiple lines.
 // Besides, this is easier to read anyway.
-func fieldSizeType1(x uint16) uint8 { return uint8(((x) & (0x3 << 6)) >> 6) }   // ........XX......
-func fieldSizeType2(x uint16) uint8 { return uint8(((x) & (0x3 << 12)) >> 12) } // ..XX............
-func fieldSizeType3(x uint16) uint8 { return uint8(((x) & (0x1 << 6)) >> 6) }   // .........X......
-func fieldCond(x uint16) uint8      { return uint8(((x) & (0xf << 8)) >> 8) }   // ....XXXX........
-func fieldVector(x uint16) uint8    { return uint8(((x) & (0xf << 0)) >> 0) }   // ............XXXX
-func fieldImm8(x uint16) uint8      { return uint8(((x) & (0xff << 0)) >> 0) }  // ........XXXXXXXX
-func fieldImm3(x uint16) uint8      { return uint8(((x) & (0x7 << 9)) >> 9) }   // ....XXX.........
-func fieldRegX(x uint16) uint8      { return uint8(((x) & (0x7 << 9)) >> 9) }   // ....XXX.........
-func fieldRegY(x uint16) uint8      { return uint8(((x) & (0x7 << 0)) >> 0) }   // .............XXX
-func fieldModeX(x uint16) uint8     { return uint8(((x) & (0x7 << 6)) >> 6) }   // .......XXX......
-func fieldModeY(x uint16) uint8     { return uint8(((x) & (0x7 << 3)) >> 3) }   // ..........XXX...
+func fieldSizeType1(x uint16) uint8    { return uint8(((x) & (0x3 << 6)) >> 6) }   // ........XX......
+func fieldSizeType2(x uint16) uint8    { return uint8(((x) & (0x3 << 12)) >> 12) } // ..XX............
+func fieldSizeType3(x uint16) uint8    { return uint8(((x) & (0x1 << 6)) >> 6) }   // .........X......
+func fieldCond(x uint16) uint8         { return uint8(((x) & (0xf << 8)) >> 8) }   // ....XXXX........
+func fieldVector(x uint16) uint8       { return uint8(((x) & (0xf << 0)) >> 0) }   // ............XXXX
+func fieldImm8(x uint16) uint8         { return uint8(((x) & (0xff << 0)) >> 0) }  // ........XXXXXXXX
+func fieldImm3(x uint16) uint8         { return uint8(((x) & (0x7 << 9)) >> 9) }   // ....XXX.........
+func fieldRegX(x uint16) uint8         { return uint8(((x) & (0x7 << 9)) >> 9) }   // ....XXX.........
+func fieldRegY(x uint16) uint8         { return uint8(((x) & (0x7 << 0)) >> 0) }   // .............XXX
+func fieldModeX(x uint16) uint8        { return uint8(((x) & (0x7 << 6)) >> 6) }   // .......XXX......
+func fieldModeY(x uint16) uint8        { return uint8(((x) & (0x7 << 3)) >> 3) }   // ..........XXX...
+func fieldShiftDir(x uint16) uint8     { return uint8(((x) & (0x1 << 8)) >> 8) }   // .......X........
+func fieldShiftKind(x uint16) uint8    { return uint8(((x) & (0x3 << 3)) >> 3) }   // ...........XX...
+func fieldShiftKindMem(x uint16) uint8 { return uint8(((x) & (0x3 << 9)) >> 9) }   // .....XX.........
 
 // NOTE: These instruction decoding functions get referenced by the auto-generated instruction decoder code.
 
@@ -707,39 +1035,49 @@ func fieldModeY(x uint16) uint8     { return uint8(((x) & (0x7 << 3)) >> 3) }
 // SIZE_TYPE2 |    | B  | L  | W  |                 |
 // SIZE_TYPE3 | W  | L  |    |    | This uses 1-bit |
 
+// Size decoders also remember the decoded size, because immediate operands need it.
 func (ctx *clientContext) decodeFieldSizeType1() (opsize, bool) {
+	res := opsizeNone
 	switch fieldSizeType1(ctx.decodingCtx.ir) {
 	case 0x0:
-		return opsizeByte, true
+		res = opsizeByte
 	case 0x1:
-		return opsizeWord, true
+		res = opsizeWord
 	case 0x2:
-		return opsizeLong, true
+		res = opsizeLong
 	default:
 		return 0, false
 	}
+	ctx.decodingCtx.opsize = res
+	return res, true
 }
 func (ctx *clientContext) decodeFieldSizeType2() (opsize, bool) {
+	res := opsizeNone
 	switch fieldSizeType2(ctx.decodingCtx.ir) {
 	case 0x1:
-		return opsizeByte, true
+		res = opsizeByte
 	case 0x3:
-		return opsizeWord, true
+		res = opsizeWord
 	case 0x2:
-		return opsizeLong, true
+		res = opsizeLong
 	default:
 		return 0, false
 	}
+	ctx.decodingCtx.opsize = res
+	return res, true
 }
 func (ctx *clientContext) decodeFieldSizeType3() (opsize, bool) {
+	res := opsizeNone
 	switch fieldSizeType3(ctx.decodingCtx.ir) {
 	case 0x0:
-		return opsizeWord, true
+		res = opsizeWord
 	case 0x1:
-		return opsizeLong, true
+		res = opsizeLong
 	default:
 		return 0, false
 	}
+	ctx.decodingCtx.opsize = res
+	return res, true
 }
 func (ctx *clientContext) decodeFieldCond() (cond, bool) {
 	res := cond(fieldCond(ctx.decodingCtx.ir))
@@ -764,6 +1102,15 @@ func (ctx *clientContext) decodeFieldRegX() (uint8, bool) {
 func (ctx *clientContext) decodeFieldRegY() (uint8, bool) {
 	return fieldRegY(ctx.decodingCtx.ir), true
 }
+func (ctx *clientContext) decodeFieldShiftDir() (shiftDir, bool) {
+	return shiftDir(fieldShiftDir(ctx.decodingCtx.ir)), true
+}
+func (ctx *clientContext) decodeFieldShiftKind() (shiftKind, bool) {
+	return shiftKind(fieldShiftKind(ctx.decodingCtx.ir)), true
+}
+func (ctx *clientContext) decodeFieldShiftKindMem() (shiftKind, bool) {
+	return shiftKind(fieldShiftKindMem(ctx.decodingCtx.ir)), true
+}
 func (ctx *clientContext) decodeEaField(mode, reg uint8) (eamode, bool) {
 	switch mode {
 	case 0:
@@ -846,6 +1193,14 @@ func (ctx *clientContext) checkEaModes(ea1Modes []eamode, ea2Modes []eamode) boo
 			return false
 		}
 	}
+	// Address registers cannot be accessed as bytes.
+	if ctx.decodingCtx.opsize == opsizeByte {
+		for _, field := range ctx.decodingCtx.eaFields {
+			if (field != nil) && (field.mode == eamodeAreg) {
+				return false
+			}
+		}
+	}
 	return true
 }
 func (ctx *clientContext) decodeEa() error {
@@ -937,6 +1292,7 @@ func (ctx *clientContext) decodeEa() error {
 			dest.indexReg = uint8((v >> 12) & 0x7)
 			dest._disp = signExtendBToL(uint8(v))
 		}
+		dest.xwordEndPc = ctx.pc
 	}
 	return nil
 }
@@ -960,6 +1316,17 @@ func (ctx *clientContext) decodeXwordImm8() (uint8, error) {
 	}
 	return uint8(v), nil
 }
+func (ctx *clientContext) decodeXwordBitNum() (uint8, error) {
+	v, err := ctx.fetchInstrW()
+	if err != nil {
+		return 0, err
+	}
+	// EA field is decoded before extension words, so we know the operand size at this point.
+	return uint8(bitNumForEa(*ctx.decodingCtx.eaFields[0], uint32(v))), nil
+}
+func (ctx *clientContext) decodeXwordRegList() (uint16, error) {
+	return ctx.fetchInstrW()
+}
 func (ctx *clientContext) decodeXwordImm16() (uint16, error) {
 	return ctx.fetchInstrW()
 }
@@ -1023,32 +1390,56 @@ const (
 
 type excError struct {
 	memExcAddr  uint32
+	pc          uint32 // PC at the time of bus/address error. Other exceptions stack the PC at the time of exception processing.
 	ir          uint16
 	memExcFlags uint8
 	exc         exc
+	intLevel    uint8 // Interrupt level, if this is an interrupt (0 otherwise)
 }
 
 func (e excError) Error() string {
 	return fmt.Sprintf("68000 Exception %#x", e.exc)
 }
 
-func (e excError) isGroup0Or1Exc() bool {
+// Exception groups, from highest to lowest priority.
+type excGroup uint8
+
+const (
+	excGroup0 = excGroup(iota) // Reset, bus error, address error: Aborts current bus cycle
+	excGroup1                  // Trace, interrupt, illegal instruction, privilege violation: Aborts or follows current instruction
+	excGroup2                  // TRAP, TRAPV, CHK, divide by zero: Caused by executing instructions
+)
+
+func (e excError) isMemExc() bool {
+	if e.intLevel != 0 {
+		// Device may give vector number of bus/address error, but it's still an interrupt.
+		return false
+	}
+	return (e.exc == excBusError) || (e.exc == excAddressError)
+}
+
+func (e excError) group() excGroup {
+	if e.intLevel != 0 {
+		// Interrupts can use any vector number given by the device.
+		return excGroup1
+	}
 	switch e.exc {
-	case excZeroDivide:
-	case excChk:
-	case excTrapv:
-	case excTrace:
-		return true
+	case excResetSsp, excResetPc, excBusError, excAddressError:
+		return excGroup0
+	case excZeroDivide, excChk, excTrapv:
+		return excGroup2
 	default:
-		return excTrapVectorStart <= e.exc
+		if (excTrapVectorStart <= e.exc) && (e.exc < excTrapVectorStart+16) {
+			return excGroup2
+		}
+		return excGroup1
 	}
-	return false
 }
 
 func (ctx *clientContext) memExcError(exc exc, addr uint32, fc fc, dir busDir) excError {
 	flags := uint8(fc)
 	// I/N
-	if ctx.inGroup0Or1Exc {
+	if ctx.inExcProcessing {
 		flags |= 1 << 3
 	}
 	// R/W
@@ -1057,60 +1448,119 @@ func (ctx *clientContext) memExcError(exc exc, addr uint32, fc fc, dir busDir) e
 	}
 	return excError{
 		exc:         exc,
+		pc:          ctx.pc,
 		ir:          ctx.decodingCtx.ir,
 		memExcFlags: flags,
 		memExcAddr:  addr,
 	}
 }
 
-func (ctx *clientContext) fetchVector(exc exc) (uint32, error) {
-	isProgram := false
-	switch exc {
-	case excResetPc:
-	case excResetSsp:
-		isProgram = true
-	}
-	fc := ctx.getFuncCode(isProgram)
+// Reset vectors are read from program space, and others are read from data space.
+// (Vector 0 and 1 given by interrupting device are not reset vectors.)
+func (ctx *clientContext) fetchVector(exc exc, isReset bool) (uint32, error) {
+	fc := ctx.getFuncCode(isReset)
 	return ctx.readMemL(uint32(exc)*4, fc)
 }
 
-func (ctx *clientContext) beginExc(err excError) error {
+// Returns the exception that was processed in the end, which is bus or address error if one occured while processing err.
+func (ctx *clientContext) beginExc(err excError) (excError, error) {
 	// NOTE: If another exception occurs(which would be either address or bus error), we MUST handle it here.
 	// And to avoid stack overflow, we shouldn't even call this function recursively, because it is possible to cause infinite bus error loop.
 	currentErr := err
+	// If bus/address error occurs in the middle, its frame should have SR before any exception processing, not the one with S set and T cleared.
+	oldSr := ctx.readSr()
 
 	for {
-		ctx.inGroup0Or1Exc = err.isGroup0Or1Exc()
-		newPc, err := ctx.handleExc(currentErr)
+		ctx.inExcProcessing = true
+		newPc, err := ctx.handleExc(currentErr, oldSr)
 		if err != nil {
 			if excErr, isExcErr := err.(excError); isExcErr {
-				if (excErr.exc == excAddressError) && (currentErr.exc == excAddressError) {
-					// Double address error
-					if err := ctx.eventTraceExcMem(excErr.exc, ctx.pc, excErr.ir, excErr.memExcAddr, excErr.memExcFlags); err != nil {
-						return err
-					}
-					return err
+				if (excErr.group() == excGroup0) && (currentErr.group() == excGroup0) {
+					// Double bus/address error
+					return excErr, ctx.doubleFault(excErr)
 				} else {
-					// Begin new exception
+					// Begin new exception. Group 0 exception takes priority over the one being processed.
 					currentErr = excErr
 					continue
 				}
 			} else {
 				// Not an exception error (e.g. network error)
-				return err
+				return currentErr, err
 			}
 		}
-		ctx.inGroup0Or1Exc = false
+		ctx.inExcProcessing = false
 		ctx.pc = newPc
 		break
 	}
-	return nil
+	return currentErr, nil
+}
+
+// Begins trace exception after an instruction was executed with T bit set.
+func (ctx *clientContext) beginTraceExc() error {
+	if ctx.halted {
+		// Double fault occured while processing the previous exception
+		return nil
+	}
+	// If STOP was traced, the CPU leaves stopped state and processes the trace exception immediately.
+	ctx.stopped = false
+	_, err := ctx.beginExc(excError{exc: excTrace})
+	return err
+}
+
+// Runs the reset sequence, as if RESET and HALT inputs were asserted by an external device.
+func (ctx *clientContext) reset() error {
+	ctx.decodingCtx = decodingContext{}
+	ctx.srS = true
+	ctx.srT = false
+	ctx.srI = 7
+	ctx.stopped = false
+	ctx.halted = false
+	ctx.inExcProcessing = false
+	err := func() error {
+		if v, err := ctx.fetchVector(excResetSsp, true); err != nil {
+			return err
+		} else {
+			ctx.a7ssp = v
+		}
+		if v, err := ctx.fetchVector(excResetPc, true); err != nil {
+			return err
+		} else {
+			ctx.pc = v
+		}
+		return nil
+	}()
+	if excErr, isExcErr := err.(excError); isExcErr {
+		// Bus error while fetching reset vectors is a double fault.
+		return ctx.doubleFault(excErr)
+	}
+	return err
 }
 
-// Internal helper
-func (ctx *clientContext) handleExc(err excError) (uint32, error) {
+// Halts the CPU after a bus or address error occured during group 0 exception processing(or reset).
+func (ctx *clientContext) doubleFault(err excError) error {
+	if ctx.traceExc {
+		if err := ctx.eventTraceExcMem(err.exc, err.pc, err.ir, err.memExcAddr, err.memExcFlags); err != nil {
+			return err
+		}
+	}
+	ctx.halted = true
+	ctx.stopped = false
+	ctx.inExcProcessing = false
+	return ctx.eventHalt()
+}
+
+// Internal helper. oldSr is the SR to be stacked.
+func (ctx *clientContext) handleExc(err excError, oldSr uint16) (uint32, error) {
+	// Stacked PC depends on the exception:
+	// - Bus/address error: PC at the time of error (See excError.pc)
+	// - Illegal instruction, privilege violation: Address of the instruction that caused it
+	// - TRAP, TRAPV, CHK, divide by zero, trace, interrupt: Address of the next instruction
+	// Caller is responsible for setting ctx.pc for the latter two.
 	pc := ctx.pc
-	isMemErr := (err.exc == excBusError) || (err.exc == excAddressError)
+	isMemErr := err.isMemExc()
+	if isMemErr {
+		pc = err.pc
+	}
 	if ctx.traceExc {
 		if isMemErr {
 			if err := ctx.eventTraceExcMem(err.exc, pc, err.ir, err.memExcAddr, err.memExcFlags); err != nil {
@@ -1122,12 +1572,14 @@ func (ctx *clientContext) handleExc(err excError) (uint32, error) {
 			}
 		}
 	}
-	oldSr := ctx.readSr()
 	ctx.srS = true
 	ctx.srT = false
+	if err.intLevel != 0 {
+		// Interrupts of the same or lower level are masked until the handler returns.
+		ctx.srI = err.intLevel
+	}
 	newPc := uint32(0)
-	// TODO: Should we set srI as well?
-	if v, err := ctx.fetchVector(err.exc); err != nil {
+	if v, err := ctx.fetchVector(err.exc, false); err != nil {
 		return 0, err
 	} else {
 		newPc = v
@@ -1156,6 +1608,42 @@ func (ctx *clientContext) handleExc(err excError) (uint32, error) {
 	return newPc, nil
 }
 
+//==============================================================================
+// Interrupts
+//==============================================================================
+
+// Returns the interrupt level that should be serviced now, or 0 if there's none.
+func (ctx *clientContext) pendingIntLevel() uint8 {
+	// Level 7 cannot be masked, but it's only serviced once when IPL changes to 7.
+	if (ctx.ipl == 7) && ctx.nmiPending {
+		return 7
+	}
+	if ctx.srI < ctx.ipl {
+		return ctx.ipl
+	}
+	return 0
+}
+
+func (ctx *clientContext) setIpl(level uint8) {
+	if (level == 7) && (ctx.ipl != 7) {
+		ctx.nmiPending = true
+	}
+	ctx.ipl = level
+}
+
+func (ctx *clientContext) serviceInterrupt(level uint8) error {
+	ctx.stopped = false
+	if level == 7 {
+		ctx.nmiPending = false
+	}
+	vector, err := ctx.intAckCycle(level)
+	if err != nil {
+		return err
+	}
+	_, err = ctx.beginExc(excError{exc: vector, intLevel: level})
+	return err
+}
+
 //==============================================================================
 // Stack
 //==============================================================================
@@ -1194,10 +1682,216 @@ func (ctx *clientContext) popL() (uint32, error) {
 	}
 }
 
+//==============================================================================
+// Execution
+//==============================================================================
+
+type tickResult struct {
+	idle     bool // CPU was stopped or halted, and nothing happened
+	excTaken bool // Exception processing happened (including interrupts and trace)
+	failed   bool // Exception processing failed
+}
+
+// Runs the CPU for a tick, which is either executing an instruction, or processing an interrupt.
+// Returned error is non-exception error(e.g. network error).
+//
+// When multiple exceptions happen at once, they are processed in following order:
+//  1. Bus/address error (group 0): Aborts the instruction (or exception processing), and other pending exceptions are discarded.
+//  2. TRAP, TRAPV, CHK, divide by zero (group 2): These are part of the instruction, so they come before trace.
+//  3. Trace: Only if the instruction was executed, i.e. not aborted by group 0, illegal instruction or privilege violation.
+//  4. Interrupt: Checked at the beginning of next tick. Its frame goes on top, so the interrupt handler runs before the trace handler.
+//  5. Illegal instruction, privilege violation: Detected when the next instruction is decoded, which is after interrupt is checked.
+func (ctx *clientContext) tick(logger *log.Logger) (tickResult, error) {
+	result := tickResult{}
+	if ctx.halted {
+		// Nothing happens until the CPU is reset.
+		result.idle = true
+		return result, nil
+	}
+	if level := ctx.pendingIntLevel(); level != 0 {
+		// Interrupt processing takes the whole tick. This also wakes up the CPU if it's stopped.
+		result.excTaken = true
+		if err := ctx.serviceInterrupt(level); err != nil {
+			logger.Printf("serviceInterrupt failed with error: %v", err)
+			result.failed = true
+		}
+		return result, nil
+	}
+	if ctx.stopped {
+		result.idle = true
+		return result, nil
+	}
+	instrPc := ctx.pc
+	// Whether trace exception occurs is decided by T bit at the beginning of the instruction.
+	// (e.g. If MOVE to SR sets T, next instruction will be traced, not MOVE itself.)
+	traceActive := ctx.srT
+	ctx.decodingCtx = decodingContext{}
+	executed := false
+	err := func() error {
+		if v, err := ctx.fetchInstrW(); err != nil {
+			return err
+		} else {
+			ctx.decodingCtx.ir = v
+		}
+		if (ctx.decodingCtx.ir >> 12) == 0xa {
+			return excError{exc: excLineA}
+		}
+		if (ctx.decodingCtx.ir >> 12) == 0xf {
+			return excError{exc: excLineF}
+		}
+		instr, err := ctx.instrDecode()
+		if err != nil {
+			return err
+		}
+		if ctx.traceExec {
+			disasm := instr.disasm()
+			if err := ctx.eventTraceExec(instrPc, ctx.decodingCtx.ir, disasm); err != nil {
+				return err
+			}
+		}
+		if err := instr.exec(ctx); err != nil {
+			if excErr, isExcErr := err.(excError); isExcErr && ((excErr.exc == excPrivilegeViolation) || (excErr.exc == excIllegalInstr)) {
+				// ILLEGAL instruction is decoded like other instructions, but it's not executed either.
+				executed = false
+			} else {
+				executed = true
+			}
+			return err
+		}
+		executed = true
+		return nil
+	}()
+	if !executed {
+		ctx.pc = instrPc
+	} else {
+		ctx.lastExecutedIr = ctx.decodingCtx.ir
+	}
+	if err != nil {
+		excErr, isExcErr := err.(excError)
+		if !isExcErr {
+			// Non-exception error occured
+			return result, err
+		}
+		result.excTaken = true
+		processedErr, err := ctx.beginExc(excErr)
+		if err != nil {
+			logger.Printf("beginExc failed with error: %v", err)
+			result.failed = true
+			return result, nil
+		}
+		if !traceActive || (processedErr.group() != excGroup2) {
+			// Exceptions other than group 2(e.g. privilege violation) abort the instruction, and it doesn't get traced.
+			// This includes bus/address error that occured while processing group 2 exception.
+			return result, nil
+		}
+		// Instruction was executed, so trace exception is processed after the trap exception.
+	}
+	if traceActive {
+		result.excTaken = true
+		if err := ctx.beginTraceExc(); err != nil {
+			logger.Printf("beginTraceExc failed with error: %v", err)
+			result.failed = true
+		}
+	}
+	return result, nil
+}
+
+// Runs up to maxCount ticks, and returns number of ticks that were run and the reason it stopped.
+func (ctx *clientContext) run(logger *log.Logger, maxCount uint32, flags netRunFlags, untilPc uint32) (uint32, netRunReason, error) {
+	count := uint32(0)
+	for count < maxCount {
+		result, err := ctx.tick(logger)
+		if err != nil {
+			return count, 0, err
+		}
+		if result.idle {
+			if ctx.halted {
+				return count, netRunReasonHalted, nil
+			}
+			return count, netRunReasonStopped, nil
+		}
+		count++
+		switch {
+		case result.failed:
+			return count, netRunReasonFailed, nil
+		case ctx.halted:
+			return count, netRunReasonHalted, nil
+		case result.excTaken && ((flags & netRunFlagUntilExc) != 0):
+			return count, netRunReasonExc, nil
+		case ((flags & netRunFlagUntilPc) != 0) && (ctx.pc == untilPc):
+			return count, netRunReasonPc, nil
+		case ctx.stopped:
+			return count, netRunReasonStopped, nil
+		}
+	}
+	return count, netRunReasonCount, nil
+}
+
+//==============================================================================
+// Memory regions
+//==============================================================================
+
+const memRegionAddrLimit = 1 << 24 // 68000 only has 24-bit address bus
+
+type memRegion struct {
+	start    uint32
+	data     []uint8
+	readOnly bool // ROM region. Writes from the CPU are ignored, but the client can still upload to it.
+}
+
+func (r *memRegion) end() uint32 {
+	return r.start + uint32(len(r.data))
+}
+func (r *memRegion) contains(addr uint32, size uint32) bool {
+	return (r.start <= addr) && (uint64(addr)+uint64(size) <= uint64(r.end()))
+}
+func (r *memRegion) readW(addr uint32, ds netDs) uint16 {
+	offset := addr - r.start
+	v := (uint16(r.data[offset]) << 8) | uint16(r.data[offset+1])
+	return v & ds.valueMask()
+}
+func (r *memRegion) writeW(addr uint32, ds netDs, v uint16) {
+	if r.readOnly {
+		return
+	}
+	offset := addr - r.start
+	if (ds & netDsUpper) != 0 {
+		r.data[offset] = uint8(v >> 8)
+	}
+	if (ds & netDsLower) != 0 {
+		r.data[offset+1] = uint8(v)
+	}
+}
+
+// Returns the region that fully contains given range, or nil if there's none.
+func (ctx *clientContext) findMemRegion(addr uint32, size uint32) *memRegion {
+	for i := range ctx.memRegions {
+		if ctx.memRegions[i].contains(addr, size) {
+			return &ctx.memRegions[i]
+		}
+	}
+	return nil
+}
+
+// Returns false if the region is not word-aligned, is outside of 24-bit address space, or overlaps with existing one.
+func (ctx *clientContext) mapMemRegion(start uint32, size uint32, readOnly bool) bool {
+	if ((start % 2) != 0) || ((size % 2) != 0) || (size == 0) || (memRegionAddrLimit < uint64(start)+uint64(size)) {
+		return false
+	}
+	for _, r := range ctx.memRegions {
+		if (start < r.end()) && (r.start < start+size) {
+			return false
+		}
+	}
+	ctx.memRegions = append(ctx.memRegions, memRegion{start: start, data: make([]uint8, size), readOnly: readOnly})
+	return true
+}
+
 //==============================================================================
 // Memory bus
 //==============================================================================
 
+// NOTE: This is also sent to the client as part of address asserted event.
 type busDir uint8
 
 const (
@@ -1253,23 +1947,80 @@ func (ctx *clientContext) readBus(addr uint32, ds netDs, fc fc) (uint16, error)
 		return 0, ctx.memExcError(excAddressError, addr, fc, busDirRead)
 	}
 	addr &= ^uint32(0xff000000) // Limit to 24-bit
-	if err := ctx.eventAddrAsserted(addr); err != nil {
+	if r := ctx.findMemRegion(addr, 2); r != nil {
+		return r.readW(addr, ds), nil
+	}
+	if v, resp, err := ctx.clientBusCycle(addr, ds, fc, busDirRead, 0); err != nil {
 		return 0, err
+	} else if resp != netOpbyteAck {
+		return 0, ctx.memExcError(excBusError, addr, fc, busDirRead)
+	} else {
+		return v, nil
 	}
-	return ctx.eventReadBus(ds)
 }
 func (ctx *clientContext) writeBus(addr uint32, ds netDs, fc fc, v uint16) error {
 	if (addr & 0x1) != 0 {
 		return ctx.memExcError(excAddressError, addr, fc, busDirWrite)
 	}
 	addr &= ^uint32(0xff000000) // Limit to 24-bit
-	if err := ctx.eventAddrAsserted(addr); err != nil {
+	if r := ctx.findMemRegion(addr, 2); r != nil {
+		r.writeW(addr, ds, v)
+		return nil
+	}
+	if _, resp, err := ctx.clientBusCycle(addr, ds, fc, busDirWrite, v); err != nil {
 		return err
+	} else if resp != netOpbyteAck {
+		return ctx.memExcError(excBusError, addr, fc, busDirWrite)
 	}
-	return ctx.eventWriteBus(ds, v)
+	return nil
 }
-func (ctx *clientContext) readMemL(addr uint32, fc fc) (uint32, error) {
-	result := uint32(0)
+
+// Forwards the bus cycle to the client. v is the value to write, and ignored for reads.
+// Returned response is netOpbyteAck on success, netOpbyteFail if the client asserted BERR, or netOpbyteAutovector if it asserted VPA.
+func (ctx *clientContext) clientBusCycle(addr uint32, ds netDs, fc fc, dir busDir, v uint16) (uint16, netOpbyte, error) {
+	if ctx.busMode == netBusModeCombined {
+		return ctx.eventBusCycle(addr, fc, dir, ds, v)
+	}
+	if ok, err := ctx.eventAddrAsserted(addr, fc, dir, ds); err != nil {
+		return 0, netOpbyteFail, err
+	} else if !ok {
+		return 0, netOpbyteFail, nil
+	}
+	if dir == busDirRead {
+		return ctx.eventReadBus(ds)
+	}
+	if ok, err := ctx.eventWriteBus(ds, v); err != nil || !ok {
+		return 0, netOpbyteFail, err
+	}
+	return 0, netOpbyteAck, nil
+}
+
+// Runs interrupt acknowledge cycle, and returns the vector to use.
+// This is a byte read cycle in CPU space(FC=7), with the interrupt level on A1~A3 and all upper address lines set.
+// Memory regions are not used, so the cycle always goes to the client.
+func (ctx *clientContext) intAckCycle(level uint8) (exc, error) {
+	// IACK reads the byte at 0xfffff1|level<<1. Like other byte reads, A0 is carried by the data strobe(LDS).
+	addr := uint32(0xfffff1) | (uint32(level) << 1)
+	v, resp, err := ctx.clientBusCycle(addr&^uint32(0x1), netDsLower, fcCpuSpace, busDirRead, 0)
+	if err != nil {
+		return 0, err
+	}
+	switch resp {
+	case netOpbyteAck:
+		// Vector number comes from the lower half of the data bus.
+		// Like 68000, it's used as-is even if it's below 64(i.e. reserved for the CPU).
+		// It's still processed as an interrupt: It doesn't create bus/address error frame, and vector 0 and 1 are read
+		// from supervisor data space instead of program space. (See excError.group, excError.isMemExc, fetchVector)
+		return exc(uint8(v)), nil
+	case netOpbyteAutovector:
+		return excLevel1InterruptAutovector + exc(level-1), nil
+	default:
+		// Bus error during interrupt acknowledge
+		return excSpuriousInterrupt, nil
+	}
+}
+func (ctx *clientContext) readMemL(addr uint32, fc fc) (uint32, error) {
+	result := uint32(0)
 	if v, err := ctx.readBus(addr, netDsBoth, fc); err != nil {
 		return 0, err
 	} else {
@@ -1303,6 +2054,22 @@ func (ctx *clientContext) readMemB(addr uint32, fc fc) (uint8, error) {
 		return uint8(v >> shift), nil
 	}
 }
+
+// Same as readMemL, but the low word is read first. 68000 does this for -(An) operands of some instructions.
+func (ctx *clientContext) readMemLLowFirst(addr uint32, fc fc) (uint32, error) {
+	result := uint32(0)
+	if v, err := ctx.readBus(addr+2, netDsBoth, fc); err != nil {
+		return 0, err
+	} else {
+		result = uint32(v)
+	}
+	if v, err := ctx.readBus(addr, netDsBoth, fc); err != nil {
+		return 0, err
+	} else {
+		result |= uint32(v) << 16
+	}
+	return result, nil
+}
 func (ctx *clientContext) readMem(addr uint32, fc fc, size opsize) (uint32, error) {
 	switch size {
 	case opsizeByte:
@@ -1332,6 +2099,17 @@ func (ctx *clientContext) writeMemL(addr uint32, fc fc, v uint32) error {
 	}
 	return nil
 }
+
+// Same as writeMemL, but the low word is written first. 68000 does this for -(An) operands of some instructions.
+func (ctx *clientContext) writeMemLLowFirst(addr uint32, fc fc, v uint32) error {
+	if err := ctx.writeBus(addr+2, netDsBoth, fc, uint16(v)); err != nil {
+		return err
+	}
+	if err := ctx.writeBus(addr, netDsBoth, fc, uint16(v>>16)); err != nil {
+		return err
+	}
+	return nil
+}
 func (ctx *clientContext) writeMemW(addr uint32, fc fc, v uint16) error {
 	return ctx.writeBus(addr, netDsBoth, fc, v)
 }
@@ -1375,6 +2153,9 @@ const (
 	// Every response starts with this byte,
 	netOpbyteAck  = netOpbyte(0x00) // Acknowledged
 	netOpbyteFail = netOpbyte(0x01) // Failed
+	// Only valid as a response to read bus/bus cycle events during interrupt acknowledge(FC=7).
+	// Tells the CPU to use autovector(i.e. Device asserted VPA instead of providing the vector number).
+	netOpbyteAutovector = netOpbyte(0x02)
 
 	// 1x - General commands
 	netOpbyteBye          = netOpbyte(0x10) // Close the connection
@@ -1384,6 +2165,12 @@ const (
 	netOpbyteTraceExecOff = netOpbyte(0x14) // Trace Execution - Disable
 	netOpbyteTraceExcOn   = netOpbyte(0x15) // Trace Exception - Enable
 	netOpbyteTraceExcOff  = netOpbyte(0x16) // Trace Exception - Disable
+	netOpbyteSetIpl       = netOpbyte(0x17) // Set interrupt priority level(IPL) input
+	netOpbyteReset        = netOpbyte(0x18) // Assert RESET input and run the reset sequence
+	netOpbyteIsHalted     = netOpbyte(0x19) // Is the CPU halted(due to double fault)?
+	netOpbyteUnhalt       = netOpbyte(0x1a) // Bring the CPU out of halted state, without resetting it (Client must restore PC/SR/SSP)
+	netOpbyteSetBusMode   = netOpbyte(0x1b) // Set how bus cycles are sent to the client (See netBusMode)
+	netOpbyteRun          = netOpbyte(0x1c) // Run the CPU for multiple ticks (See netRunFlags and netRunReason)
 	netOpbyteTick         = netOpbyte(0x1f) // Run the CPU for a tick
 
 	// 2x - CPU state manipulation commands
@@ -1400,6 +2187,13 @@ const (
 	netOpbyteSrWrite   = netOpbyte(0x2a) // SR write
 	netOpbyteSrRead    = netOpbyte(0x2b) // SR Read
 
+	// Memory regions
+	netOpbyteMapRam      = netOpbyte(0x30) // Map RAM region held by the server
+	netOpbyteMapRom      = netOpbyte(0x31) // Map ROM region held by the server
+	netOpbyteUnmapAll    = netOpbyte(0x32) // Remove all RAM/ROM regions
+	netOpbyteMemUpload   = netOpbyte(0x33) // Write bytes to RAM/ROM region
+	netOpbyteMemDownload = netOpbyte(0x34) // Read bytes from RAM/ROM region
+
 	// 8x - Server events
 	// When client receives one of these, it should respond to it accordingly.
 	netOpbyteEventAddrAsserted = netOpbyte(0x80) // Address asserted
@@ -1409,6 +2203,8 @@ const (
 	netOpbyteEventTraceExec    = netOpbyte(0x84) // Event for Trace Execution
 	netOpbyteEventTraceExc     = netOpbyte(0x85) // Event for Trace Exception (Non-memory exception)
 	netOpbyteEventTraceExcMem  = netOpbyte(0x86) // Event for Trace Exception (Memory exception)
+	netOpbyteEventHalt         = netOpbyte(0x88) // CPU halted due to double fault
+	netOpbyteEventBusCycle     = netOpbyte(0x89) // Whole bus cycle in a single message (netBusModeCombined only)
 )
 
 // 68000 has pins called UDS(Upper Data Strobe) and LDS(Lower Data Strobe), and these signals tell the bus to only look at upper or lower 8-bit of the 16-bit external bus.
@@ -1421,8 +2217,45 @@ const (
 	netDsBoth  = netDs(netDsLower | netDsUpper)
 )
 
+// Bus mode decides which events are used for bus cycles that go to the client.
+type netBusMode uint8
+
+const (
+	netBusModeTwoPhase = netBusMode(0) // Address asserted event, followed by read or write bus event
+	netBusModeCombined = netBusMode(1) // Single bus cycle event
+)
+
+// Flags for netOpbyteRun
+type netRunFlags uint8
+
+const (
+	netRunFlagUntilPc  = netRunFlags(1 << 0) // Stop when PC reaches given address
+	netRunFlagUntilExc = netRunFlags(1 << 1) // Stop when exception processing happens (including interrupts and trace)
+)
+
+// Reason why netOpbyteRun stopped
+type netRunReason uint8
+
+const (
+	netRunReasonCount   = netRunReason(0) // Ran requested number of ticks
+	netRunReasonPc      = netRunReason(1) // PC reached the address
+	netRunReasonStopped = netRunReason(2) // CPU is stopped (STOP instruction)
+	netRunReasonHalted  = netRunReason(3) // CPU is halted (double fault)
+	netRunReasonExc     = netRunReason(4) // Exception processing happened
+	netRunReasonFailed  = netRunReason(5) // Exception processing failed (same as Tick returning FAIL)
+)
+
 func (ctx *clientContext) main() {
-	logger := log.New(log.Writer(), fmt.Sprintf("[client/%s] ", ctx.conn.RemoteAddr()), log.Flags())
+	logger := log.New(log.Writer(), fmt.Sprintf("[session %d/%s] ", ctx.sessionId, ctx.conn.RemoteAddr()), log.Flags())
+	defer func() {
+		// A bug hit by one client shouldn't bring down other sessions.
+		if r := recover(); r != nil {
+			logger.Printf("Panic occured: %v\n%s", r, debug.Stack())
+		}
+		logger.Printf("Closing client connection")
+		ctx.conn.Close()
+		logger.Printf("Closed client connection")
+	}()
 	for !ctx.closed {
 		err := ctx.serveNextCmd(logger)
 		if err != nil {
@@ -1430,9 +2263,6 @@ func (ctx *clientContext) main() {
 			break
 		}
 	}
-	logger.Printf("Closing client connection")
-	ctx.conn.Close()
-	logger.Printf("Closed client connection")
 }
 func (ctx *clientContext) serveNextCmd(logger *log.Logger) error {
 	const (
@@ -1515,77 +2345,121 @@ func (ctx *clientContext) serveNextCmd(logger *log.Logger) error {
 			return err
 		}
 
+	case netOpbyteSetIpl:
+		level, err := ctx.inB()
+		if err != nil {
+			return err
+		}
+		if debugNetmsg {
+			logger.Printf("SetIpl %d", level)
+		}
+		if 7 < level {
+			return ctx.outFail()
+		}
+		ctx.setIpl(level)
+		res := newNetAckResponse(0)
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
+	case netOpbyteReset:
+		if debugNetmsg {
+			logger.Printf("Reset")
+		}
+		if err := ctx.reset(); err != nil {
+			return err
+		}
+		res := newNetAckResponse(0)
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
+	case netOpbyteIsHalted:
+		if debugNetmsg {
+			logger.Printf("IsHalted")
+		}
+		res := newNetAckResponse(1)
+		if ctx.halted {
+			res.appendB(1)
+		} else {
+			res.appendB(0)
+		}
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
+	case netOpbyteUnhalt:
+		if debugNetmsg {
+			logger.Printf("Unhalt")
+		}
+		// This doesn't run the reset sequence, so PC, SR and SSP are left as they were when the CPU halted.
+		// The client must set these before continuing, or use Reset instead.
+		ctx.halted = false
+		ctx.stopped = false
+		ctx.inExcProcessing = false
+		res := newNetAckResponse(0)
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
+	case netOpbyteSetBusMode:
+		mode, err := ctx.inB()
+		if err != nil {
+			return err
+		}
+		if debugNetmsg {
+			logger.Printf("SetBusMode %d", mode)
+		}
+		switch netBusMode(mode) {
+		case netBusModeTwoPhase, netBusModeCombined:
+		default:
+			return ctx.outFail()
+		}
+		ctx.busMode = netBusMode(mode)
+		res := newNetAckResponse(0)
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
 	case netOpbyteTick:
 		if debugNetmsg {
 			logger.Printf("Tick")
 		}
-		if ctx.stopped {
-			res := newNetAckResponse(0)
-			if err := ctx.out(res); err != nil {
-				return err
-			}
-		} else {
-			instrPc := ctx.pc
-			ctx.decodingCtx = decodingContext{}
-			executed := false
-			err := func() error {
-				if v, err := ctx.fetchInstrW(); err != nil {
-					return err
-				} else {
-					ctx.decodingCtx.ir = v
-				}
-				if (ctx.decodingCtx.ir >> 12) == 0xa {
-					return excError{exc: excLineA}
-				}
-				if (ctx.decodingCtx.ir >> 12) == 0xf {
-					return excError{exc: excLineF}
-				}
-				instr, err := ctx.instrDecode()
-				if err != nil {
-					return err
-				}
-				if ctx.traceExec {
-					disasm := instr.disasm()
-					if err := ctx.eventTraceExec(instrPc, ctx.decodingCtx.ir, disasm); err != nil {
-						return err
-					}
-				}
-				if err := instr.exec(ctx); err != nil {
-					if excErr, isExcErr := err.(excError); isExcErr && excErr.exc == excPrivilegeViolation {
-						executed = false
-					} else {
-						executed = true
-					}
-					return err
-				}
-				executed = true
-				return nil
-			}()
-			if !executed {
-				ctx.pc = instrPc
-			} else {
-				ctx.lastExecutedIr = ctx.decodingCtx.ir
-			}
-			if err != nil {
-				if excErr, isExcErr := err.(excError); isExcErr {
-					res := newNetAckResponse(0)
-					if err := ctx.beginExc(excErr); err != nil {
-						logger.Printf("beginExc failed with error: %v", err)
-						res = newNetFailResponse()
-					}
-					if err := ctx.out(res); err != nil {
-						return err
-					}
-				} else {
-					// Non-exception error occured
-					return err
-				}
-			} else {
-				res := newNetAckResponse(0)
-				if err := ctx.out(res); err != nil {
-					return err
-				}
-			}
+		res := newNetAckResponse(0)
+		if result, err := ctx.tick(logger); err != nil {
+			return err
+		} else if result.failed {
+			res = newNetFailResponse()
+		}
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
+	case netOpbyteRun:
+		maxCount, err := ctx.inL()
+		if err != nil {
+			return err
+		}
+		flags, err := ctx.inB()
+		if err != nil {
+			return err
+		}
+		untilPc, err := ctx.inL()
+		if err != nil {
+			return err
+		}
+		if debugNetmsg {
+			logger.Printf("Run %d %#x %#x", maxCount, flags, untilPc)
+		}
+		count, reason, err := ctx.run(logger, maxCount, netRunFlags(flags), untilPc)
+		if err != nil {
+			return err
+		}
+		res := newNetAckResponse(5)
+		res.appendL(count)
+		res.appendB(uint8(reason))
+		if err := ctx.out(res); err != nil {
+			return err
 		}
 
 	case netOpbyteDregWrite:
@@ -1763,6 +2637,94 @@ func (ctx *clientContext) serveNextCmd(logger *log.Logger) error {
 			return err
 		}
 
+	case netOpbyteMapRam, netOpbyteMapRom:
+		start, err := ctx.inL()
+		if err != nil {
+			return err
+		}
+		size, err := ctx.inL()
+		if err != nil {
+			return err
+		}
+		readOnly := netOpbyte(hdrByte) == netOpbyteMapRom
+		if debugNetmsg {
+			if readOnly {
+				logger.Printf("MapRom %#x %#x", start, size)
+			} else {
+				logger.Printf("MapRam %#x %#x", start, size)
+			}
+		}
+		if !ctx.mapMemRegion(start, size, readOnly) {
+			return ctx.outFail()
+		}
+		res := newNetAckResponse(0)
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
+	case netOpbyteUnmapAll:
+		if debugNetmsg {
+			logger.Printf("UnmapAll")
+		}
+		ctx.memRegions = nil
+		res := newNetAckResponse(0)
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
+	case netOpbyteMemUpload:
+		addr, err := ctx.inL()
+		if err != nil {
+			return err
+		}
+		size, err := ctx.inL()
+		if err != nil {
+			return err
+		}
+		if debugNetmsg {
+			logger.Printf("MemUpload %#x %#x", addr, size)
+		}
+		if memRegionAddrLimit < size {
+			// We can't skip the data in this case, as we can't trust the size.
+			return fmt.Errorf("communication error: upload size %#x is too large", size)
+		}
+		data := make([]uint8, size)
+		if _, err := io.ReadFull(ctx.reader, data); err != nil {
+			return err
+		}
+		r := ctx.findMemRegion(addr, size)
+		if r == nil {
+			return ctx.outFail()
+		}
+		copy(r.data[addr-r.start:], data)
+		res := newNetAckResponse(0)
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
+	case netOpbyteMemDownload:
+		addr, err := ctx.inL()
+		if err != nil {
+			return err
+		}
+		size, err := ctx.inL()
+		if err != nil {
+			return err
+		}
+		if debugNetmsg {
+			logger.Printf("MemDownload %#x %#x", addr, size)
+		}
+		r := ctx.findMemRegion(addr, size)
+		if r == nil {
+			return ctx.outFail()
+		}
+		res := newNetAckResponse(4 + int(size))
+		res.appendL(size)
+		res.appendBytes(r.data[addr-r.start : addr-r.start+size])
+		if err := ctx.out(res); err != nil {
+			return err
+		}
+
 	default:
 		logger.Printf("Unrecognized message type %x", hdrByte)
 		if err := ctx.outFail(); err != nil {
@@ -1772,39 +2734,61 @@ func (ctx *clientContext) serveNextCmd(logger *log.Logger) error {
 	return nil
 }
 
-func (ctx *clientContext) eventAddrAsserted(addr uint32) error {
+// Bus events return ok=false if the client responded with FAIL (i.e. BERR was asserted).
+func (ctx *clientContext) eventAddrAsserted(addr uint32, fc fc, dir busDir, ds netDs) (ok bool, err error) {
 	// Send event --------------------------------------------------------------
-	event := newNetEvent(netOpbyteEventAddrAsserted, 4)
+	event := newNetEvent(netOpbyteEventAddrAsserted, 7)
 	event.appendL(addr)
+	event.appendB(uint8(fc))
+	event.appendB(uint8(dir))
+	event.appendB(uint8(ds))
 	if err := ctx.out(event); err != nil {
-		return err
+		return false, err
 	}
 	// Receive response --------------------------------------------------------
-	return ctx.expectAckOrFail()
+	return ctx.inAckOrFail()
 }
-func (ctx *clientContext) eventReadBus(ds netDs) (uint16, error) {
+func (ctx *clientContext) eventReadBus(ds netDs) (v uint16, resp netOpbyte, err error) {
 	// Send event --------------------------------------------------------------
 	event := newNetEvent(netOpbyteEventReadBus, 1)
 	event.appendB(uint8(ds))
 	if err := ctx.out(event); err != nil {
-		return 0, err
+		return 0, netOpbyteFail, err
 	}
 	// Receive response --------------------------------------------------------
-	if err := ctx.expectAckOrFail(); err != nil {
-		return 0, err
-	}
-	return ctx.inW()
+	return ctx.inReadBusResponse()
 }
-func (ctx *clientContext) eventWriteBus(ds netDs, v uint16) error {
+func (ctx *clientContext) eventWriteBus(ds netDs, v uint16) (ok bool, err error) {
 	// Send event --------------------------------------------------------------
 	event := newNetEvent(netOpbyteEventWriteBus, 3)
 	event.appendB(uint8(ds))
 	event.appendW(v)
 	if err := ctx.out(event); err != nil {
-		return err
+		return false, err
 	}
 	// Receive response --------------------------------------------------------
-	return ctx.expectAckOrFail()
+	return ctx.inAckOrFail()
+}
+func (ctx *clientContext) eventBusCycle(addr uint32, fc fc, dir busDir, ds netDs, v uint16) (readValue uint16, resp netOpbyte, err error) {
+	// Send event --------------------------------------------------------------
+	event := newNetEvent(netOpbyteEventBusCycle, 9)
+	event.appendL(addr)
+	event.appendB(uint8(fc))
+	event.appendB(uint8(dir))
+	event.appendB(uint8(ds))
+	event.appendW(v)
+	if err := ctx.out(event); err != nil {
+		return 0, netOpbyteFail, err
+	}
+	// Receive response --------------------------------------------------------
+	// Read value follows ACK for read cycles.
+	if dir == busDirRead {
+		return ctx.inReadBusResponse()
+	}
+	if ok, err := ctx.inAckOrFail(); err != nil || !ok {
+		return 0, netOpbyteFail, err
+	}
+	return 0, netOpbyteAck, nil
 }
 func (ctx *clientContext) eventTraceExec(pc uint32, ir uint16, disasm string) error {
 	// Send event --------------------------------------------------------------
@@ -1827,6 +2811,15 @@ func (ctx *clientContext) eventReset() error {
 	// Receive response --------------------------------------------------------
 	return ctx.expectAckOrFail()
 }
+func (ctx *clientContext) eventHalt() error {
+	// Send event --------------------------------------------------------------
+	event := newNetEvent(netOpbyteEventHalt, 0)
+	if err := ctx.out(event); err != nil {
+		return err
+	}
+	// Receive response --------------------------------------------------------
+	return ctx.expectAckOrFail()
+}
 func (ctx *clientContext) eventTraceExc(exc exc, pc uint32) error {
 	// Send event --------------------------------------------------------------
 	event := newNetEvent(netOpbyteEventTraceExc, 5)
@@ -1886,6 +2879,10 @@ func (b *sendBuf) appendL(v uint32) {
 	binary.BigEndian.PutUint32(b.dest[0:4], v)
 	b.dest = b.dest[4:]
 }
+func (b *sendBuf) appendBytes(v []uint8) {
+	copy(b.dest, v)
+	b.dest = b.dest[len(v):]
+}
 func (b *sendBuf) appendS(s string) {
 	if 255 < len(s) {
 		panic("string cannot be sent because it's too long(max: 255 bytes)")
@@ -1930,6 +2927,42 @@ func (ctx *clientContext) inL() (uint32, error) {
 	res := (uint32(bytes[0]) << 24) | (uint32(bytes[1]) << 16) | (uint32(bytes[2]) << 8) | uint32(bytes[3])
 	return res, nil
 }
+
+// Same as expectAckOrFail, but FAIL is not an error. Returns true if ACK was received.
+func (ctx *clientContext) inAckOrFail() (bool, error) {
+	ackByte, err := ctx.inB()
+	if err != nil {
+		return false, err
+	}
+	switch netOpbyte(ackByte) {
+	case netOpbyteAck:
+		return true, nil
+	case netOpbyteFail:
+		return false, nil
+	default:
+		return false, fmt.Errorf("communication error: expected ACK(%#x) or FAIL(%#x), got %#x", netOpbyteAck, netOpbyteFail, ackByte)
+	}
+}
+
+// Reads response to read bus(or bus cycle) event: ACK followed by the value, FAIL, or AUTOVECTOR.
+func (ctx *clientContext) inReadBusResponse() (uint16, netOpbyte, error) {
+	resByte, err := ctx.inB()
+	if err != nil {
+		return 0, netOpbyteFail, err
+	}
+	switch netOpbyte(resByte) {
+	case netOpbyteAck:
+		v, err := ctx.inW()
+		if err != nil {
+			return 0, netOpbyteFail, err
+		}
+		return v, netOpbyteAck, nil
+	case netOpbyteFail, netOpbyteAutovector:
+		return 0, netOpbyte(resByte), nil
+	default:
+		return 0, netOpbyteFail, fmt.Errorf("communication error: expected ACK(%#x), FAIL(%#x) or AUTOVECTOR(%#x), got %#x", netOpbyteAck, netOpbyteFail, netOpbyteAutovector, resByte)
+	}
+}
 func (ctx *clientContext) expectAckOrFail() error {
 	ackByte, err := ctx.inB()
 	if err != nil {
@@ -1991,20 +3024,1228 @@ func (instr instrMoveB) disasm() string {
 	return fmt.Sprintf("move.b %s %s", instr.ea1.ToString(), instr.ea2.ToString())
 }
 func (instr instrMoveB) exec(ctx *clientContext) error {
-	src := uint8(0)
-	if v, err := ctx.readEa(*instr.ea1, opsizeByte); err != nil {
+	return ctx.execMove(*instr.ea1, *instr.ea2, opsizeByte)
+}
+
+// MOVE.w
+func (instr instrMoveW) disasm() string {
+	return fmt.Sprintf("move.w %s %s", instr.ea1.ToString(), instr.ea2.ToString())
+}
+func (instr instrMoveW) exec(ctx *clientContext) error {
+	return ctx.execMove(*instr.ea1, *instr.ea2, opsizeWord)
+}
+
+// MOVE.l
+func (instr instrMoveL) disasm() string {
+	return fmt.Sprintf("move.l %s %s", instr.ea1.ToString(), instr.ea2.ToString())
+}
+func (instr instrMoveL) exec(ctx *clientContext) error {
+	return ctx.execMove(*instr.ea1, *instr.ea2, opsizeLong)
+}
+
+func (ctx *clientContext) execMove(src ea, dest ea, size opsize) error {
+	v, err := ctx.readEa(src, size)
+	if err != nil {
+		return err
+	}
+	if (dest.mode == eamodeAregIndPredec) && (size == opsizeLong) {
+		// For -(An) destination, 68000 writes the low word first.
+		addr := ctx.memAddrOfEa(dest, size)
+		fc := ctx.getFuncCode(false)
+		if err := ctx.writeMemLLowFirst(addr, fc, v); err != nil {
+			return dest.operandExcError(err)
+		}
+	} else if err := ctx.writeEa(dest, size, v); err != nil {
+		return err
+	}
+	ctx.setNZFlags(v, size)
+	ctx.clearVCFlags()
+	return nil
+}
+
+// MOVEQ
+func (instr instrMoveQ) disasm() string {
+	return fmt.Sprintf("moveq #%d, d%d", int8(instr.imm), instr.regX)
+}
+func (instr instrMoveQ) exec(ctx *clientContext) error {
+	v := signExtendBToL(instr.imm)
+	ctx.writeDregL(instr.regX, v)
+	ctx.setNZFlagsL(v)
+	ctx.clearVCFlags()
+	return nil
+}
+
+// MOVEA.w
+func (instr instrMoveAW) disasm() string {
+	return fmt.Sprintf("movea.w %s, a%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrMoveAW) exec(ctx *clientContext) error {
+	if v, err := ctx.readEa(*instr.ea1, opsizeWord); err != nil {
+		return err
+	} else {
+		// Word sized value gets sign-extended to 32-bit.
+		ctx.writeAregW(instr.regX, uint16(v))
+	}
+	return nil
+}
+
+// MOVEA.l
+func (instr instrMoveAL) disasm() string {
+	return fmt.Sprintf("movea.l %s, a%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrMoveAL) exec(ctx *clientContext) error {
+	if v, err := ctx.readEa(*instr.ea1, opsizeLong); err != nil {
+		return err
+	} else {
+		ctx.writeAregL(instr.regX, v)
+	}
+	return nil
+}
+
+// ==============================================================================
+// Instructions: Arithmetic
+// ==============================================================================
+
+// ADDA.w
+func (instr instrAddAW) disasm() string {
+	return fmt.Sprintf("adda.w %s, a%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrAddAW) exec(ctx *clientContext) error {
+	if v, err := ctx.readEa(*instr.ea1, opsizeWord); err != nil {
+		return err
+	} else {
+		// Source is sign-extended, and the whole register is affected. Flags are not affected.
+		ctx.writeAregL(instr.regX, ctx.readAreg(instr.regX)+signExtendWToL(uint16(v)))
+	}
+	return nil
+}
+
+// ADDA.l
+func (instr instrAddAL) disasm() string {
+	return fmt.Sprintf("adda.l %s, a%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrAddAL) exec(ctx *clientContext) error {
+	if v, err := ctx.readEa(*instr.ea1, opsizeLong); err != nil {
+		return err
+	} else {
+		ctx.writeAregL(instr.regX, ctx.readAreg(instr.regX)+v)
+	}
+	return nil
+}
+
+// ADDX Dy, Dx
+func (instr instrAddXReg) disasm() string {
+	return fmt.Sprintf("addx.%s d%d, d%d", instr.size.ToString(), instr.regY, instr.regX)
+}
+func (instr instrAddXReg) exec(ctx *clientContext) error {
+	src := ctx.readDreg(instr.regY, instr.size)
+	dest := ctx.readDreg(instr.regX, instr.size)
+	res := ctx.addAndSetFlags(dest, src, instr.size, true)
+	ctx.writeDreg(instr.regX, instr.size, res)
+	return nil
+}
+
+// ADDX -(Ay), -(Ax)
+func (instr instrAddXMem) disasm() string {
+	return fmt.Sprintf("addx.%s -(a%d), -(a%d)", instr.size.ToString(), instr.regY, instr.regX)
+}
+func (instr instrAddXMem) exec(ctx *clientContext) error {
+	return ctx.execXMem(instr.regY, instr.regX, instr.size, func(dest, src uint32) uint32 {
+		return ctx.addAndSetFlags(dest, src, instr.size, true)
+	})
+}
+
+// ADD <ea>, Dn
+func (instr instrAddEaDreg) disasm() string {
+	return fmt.Sprintf("add.%s %s, d%d", instr.size.ToString(), instr.ea1.ToString(), instr.regX)
+}
+func (instr instrAddEaDreg) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, instr.size)
+	if err != nil {
+		return err
+	}
+	dest := ctx.readDreg(instr.regX, instr.size)
+	res := ctx.addAndSetFlags(dest, src, instr.size, false)
+	ctx.writeDreg(instr.regX, instr.size, res)
+	return nil
+}
+
+// ADD Dn, <ea>
+func (instr instrAddDregEa) disasm() string {
+	return fmt.Sprintf("add.%s d%d, %s", instr.size.ToString(), instr.regX, instr.ea1.ToString())
+}
+func (instr instrAddDregEa) exec(ctx *clientContext) error {
+	src := ctx.readDreg(instr.regX, instr.size)
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.addAndSetFlags(dest, src, instr.size, false)
+	})
+}
+
+// ADDI
+func (instr instrAddI) disasm() string {
+	return fmt.Sprintf("addi.%s #%#x, %s", instr.size.ToString(), instr.imm, instr.ea1.ToString())
+}
+func (instr instrAddI) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.addAndSetFlags(dest, instr.imm, instr.size, false)
+	})
+}
+
+// ADDQ
+func (instr instrAddQ) disasm() string {
+	return fmt.Sprintf("addq.%s #%d, %s", instr.size.ToString(), quickImm(instr.imm), instr.ea1.ToString())
+}
+func (instr instrAddQ) exec(ctx *clientContext) error {
+	imm := quickImm(instr.imm)
+	if instr.ea1.mode == eamodeAreg {
+		// Address registers are always updated as a whole, and flags are not affected.
+		reg := instr.ea1.reg()
+		ctx.writeAregL(reg, ctx.readAreg(reg)+imm)
+		return nil
+	}
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.addAndSetFlags(dest, imm, instr.size, false)
+	})
+}
+
+// SUBA.w
+func (instr instrSubAW) disasm() string {
+	return fmt.Sprintf("suba.w %s, a%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrSubAW) exec(ctx *clientContext) error {
+	if v, err := ctx.readEa(*instr.ea1, opsizeWord); err != nil {
+		return err
+	} else {
+		// Source is sign-extended, and the whole register is affected. Flags are not affected.
+		ctx.writeAregL(instr.regX, ctx.readAreg(instr.regX)-signExtendWToL(uint16(v)))
+	}
+	return nil
+}
+
+// SUBA.l
+func (instr instrSubAL) disasm() string {
+	return fmt.Sprintf("suba.l %s, a%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrSubAL) exec(ctx *clientContext) error {
+	if v, err := ctx.readEa(*instr.ea1, opsizeLong); err != nil {
+		return err
+	} else {
+		ctx.writeAregL(instr.regX, ctx.readAreg(instr.regX)-v)
+	}
+	return nil
+}
+
+// SUBX Dy, Dx
+func (instr instrSubXReg) disasm() string {
+	return fmt.Sprintf("subx.%s d%d, d%d", instr.size.ToString(), instr.regY, instr.regX)
+}
+func (instr instrSubXReg) exec(ctx *clientContext) error {
+	src := ctx.readDreg(instr.regY, instr.size)
+	dest := ctx.readDreg(instr.regX, instr.size)
+	res := ctx.subAndSetFlags(dest, src, instr.size, true)
+	ctx.writeDreg(instr.regX, instr.size, res)
+	return nil
+}
+
+// SUBX -(Ay), -(Ax)
+func (instr instrSubXMem) disasm() string {
+	return fmt.Sprintf("subx.%s -(a%d), -(a%d)", instr.size.ToString(), instr.regY, instr.regX)
+}
+func (instr instrSubXMem) exec(ctx *clientContext) error {
+	return ctx.execXMem(instr.regY, instr.regX, instr.size, func(dest, src uint32) uint32 {
+		return ctx.subAndSetFlags(dest, src, instr.size, true)
+	})
+}
+
+// SUB <ea>, Dn
+func (instr instrSubEaDreg) disasm() string {
+	return fmt.Sprintf("sub.%s %s, d%d", instr.size.ToString(), instr.ea1.ToString(), instr.regX)
+}
+func (instr instrSubEaDreg) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, instr.size)
+	if err != nil {
+		return err
+	}
+	dest := ctx.readDreg(instr.regX, instr.size)
+	res := ctx.subAndSetFlags(dest, src, instr.size, false)
+	ctx.writeDreg(instr.regX, instr.size, res)
+	return nil
+}
+
+// SUB Dn, <ea>
+func (instr instrSubDregEa) disasm() string {
+	return fmt.Sprintf("sub.%s d%d, %s", instr.size.ToString(), instr.regX, instr.ea1.ToString())
+}
+func (instr instrSubDregEa) exec(ctx *clientContext) error {
+	src := ctx.readDreg(instr.regX, instr.size)
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.subAndSetFlags(dest, src, instr.size, false)
+	})
+}
+
+// SUBI
+func (instr instrSubI) disasm() string {
+	return fmt.Sprintf("subi.%s #%#x, %s", instr.size.ToString(), instr.imm, instr.ea1.ToString())
+}
+func (instr instrSubI) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.subAndSetFlags(dest, instr.imm, instr.size, false)
+	})
+}
+
+// SUBQ
+func (instr instrSubQ) disasm() string {
+	return fmt.Sprintf("subq.%s #%d, %s", instr.size.ToString(), quickImm(instr.imm), instr.ea1.ToString())
+}
+func (instr instrSubQ) exec(ctx *clientContext) error {
+	imm := quickImm(instr.imm)
+	if instr.ea1.mode == eamodeAreg {
+		// Address registers are always updated as a whole, and flags are not affected.
+		reg := instr.ea1.reg()
+		ctx.writeAregL(reg, ctx.readAreg(reg)-imm)
+		return nil
+	}
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.subAndSetFlags(dest, imm, instr.size, false)
+	})
+}
+
+// CMPA.w
+func (instr instrCmpAW) disasm() string {
+	return fmt.Sprintf("cmpa.w %s, a%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrCmpAW) exec(ctx *clientContext) error {
+	if v, err := ctx.readEa(*instr.ea1, opsizeWord); err != nil {
+		return err
+	} else {
+		// Source is sign-extended, and the whole register is compared.
+		ctx.compareAndSetFlags(ctx.readAreg(instr.regX), signExtendWToL(uint16(v)), opsizeLong)
+	}
+	return nil
+}
+
+// CMPA.l
+func (instr instrCmpAL) disasm() string {
+	return fmt.Sprintf("cmpa.l %s, a%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrCmpAL) exec(ctx *clientContext) error {
+	if v, err := ctx.readEa(*instr.ea1, opsizeLong); err != nil {
+		return err
+	} else {
+		ctx.compareAndSetFlags(ctx.readAreg(instr.regX), v, opsizeLong)
+	}
+	return nil
+}
+
+// CMPM
+func (instr instrCmpM) disasm() string {
+	return fmt.Sprintf("cmpm.%s (a%d)+, (a%d)+", instr.size.ToString(), instr.regY, instr.regX)
+}
+func (instr instrCmpM) exec(ctx *clientContext) error {
+	fc := ctx.getFuncCode(false)
+	srcAddr := ctx.readAreg(instr.regY)
+	ctx.incrementAreg(instr.regY, instr.size)
+	src, err := ctx.readMem(srcAddr, fc, instr.size)
+	if err != nil {
+		return err
+	}
+	// Note that if Ax and Ay are the same register, it gets incremented twice.
+	destAddr := ctx.readAreg(instr.regX)
+	ctx.incrementAreg(instr.regX, instr.size)
+	dest, err := ctx.readMem(destAddr, fc, instr.size)
+	if err != nil {
+		return err
+	}
+	ctx.compareAndSetFlags(dest, src, instr.size)
+	return nil
+}
+
+// CMP
+func (instr instrCmp) disasm() string {
+	return fmt.Sprintf("cmp.%s %s, d%d", instr.size.ToString(), instr.ea1.ToString(), instr.regX)
+}
+func (instr instrCmp) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, instr.size)
+	if err != nil {
+		return err
+	}
+	ctx.compareAndSetFlags(ctx.readDreg(instr.regX, instr.size), src, instr.size)
+	return nil
+}
+
+// CMPI
+func (instr instrCmpI) disasm() string {
+	return fmt.Sprintf("cmpi.%s #%#x, %s", instr.size.ToString(), instr.imm, instr.ea1.ToString())
+}
+func (instr instrCmpI) exec(ctx *clientContext) error {
+	dest, err := ctx.readEa(*instr.ea1, instr.size)
+	if err != nil {
+		return err
+	}
+	ctx.compareAndSetFlags(dest, instr.imm, instr.size)
+	return nil
+}
+
+// TST
+func (instr instrTst) disasm() string {
+	return fmt.Sprintf("tst.%s %s", instr.size.ToString(), instr.ea1.ToString())
+}
+func (instr instrTst) exec(ctx *clientContext) error {
+	v, err := ctx.readEa(*instr.ea1, instr.size)
+	if err != nil {
+		return err
+	}
+	ctx.setNZFlags(v, instr.size)
+	ctx.clearVCFlags()
+	return nil
+}
+
+// MULU
+func (instr instrMulU) disasm() string {
+	return fmt.Sprintf("mulu.w %s, d%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrMulU) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, opsizeWord)
+	if err != nil {
+		return err
+	}
+	res := uint32(ctx.readDregW(instr.regX)) * src
+	ctx.writeDregL(instr.regX, res)
+	ctx.setNZFlagsL(res)
+	ctx.clearVCFlags()
+	return nil
+}
+
+// MULS
+func (instr instrMulS) disasm() string {
+	return fmt.Sprintf("muls.w %s, d%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrMulS) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, opsizeWord)
+	if err != nil {
+		return err
+	}
+	res := uint32(int32(int16(ctx.readDregW(instr.regX))) * int32(int16(src)))
+	ctx.writeDregL(instr.regX, res)
+	ctx.setNZFlagsL(res)
+	ctx.clearVCFlags()
+	return nil
+}
+
+// DIVU
+func (instr instrDivU) disasm() string {
+	return fmt.Sprintf("divu.w %s, d%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrDivU) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, opsizeWord)
+	if err != nil {
+		return err
+	}
+	if src == 0 {
+		ctx.clearVCFlags()
+		return excError{exc: excZeroDivide}
+	}
+	dividend := ctx.readDregL(instr.regX)
+	quotient := dividend / src
+	remainder := dividend % src
+	if 0xffff < quotient {
+		// Destination is left unchanged on overflow.
+		ctx.ccrN = true
+		ctx.ccrZ = false
+		ctx.ccrV = true
+		ctx.ccrC = false
+		return nil
+	}
+	ctx.writeDregL(instr.regX, (remainder<<16)|quotient)
+	ctx.setNZFlagsW(uint16(quotient))
+	ctx.clearVCFlags()
+	return nil
+}
+
+// DIVS
+func (instr instrDivS) disasm() string {
+	return fmt.Sprintf("divs.w %s, d%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrDivS) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, opsizeWord)
+	if err != nil {
+		return err
+	}
+	if src == 0 {
+		ctx.clearVCFlags()
+		return excError{exc: excZeroDivide}
+	}
+	// We use 64-bit values here, so that -0x80000000 / -1 doesn't blow up.
+	dividend := int64(int32(ctx.readDregL(instr.regX)))
+	divisor := int64(int16(src))
+	quotient := dividend / divisor
+	remainder := dividend % divisor // Remainder has the same sign as the dividend.
+	if (quotient < -0x8000) || (0x7fff < quotient) {
+		// Destination is left unchanged on overflow. Flags are set the same way as DIVU.
+		ctx.ccrN = true
+		ctx.ccrZ = false
+		ctx.ccrV = true
+		ctx.ccrC = false
+		return nil
+	}
+	ctx.writeDregL(instr.regX, (uint32(uint16(remainder))<<16)|uint32(uint16(quotient)))
+	ctx.setNZFlagsW(uint16(quotient))
+	ctx.clearVCFlags()
+	return nil
+}
+
+// ADDQ, SUBQ and shift instructions encode 8 as 0.
+func quickImm(imm uint8) uint32 {
+	if imm == 0 {
+		return 8
+	}
+	return uint32(imm)
+}
+
+// ABCD Dy, Dx
+func (instr instrAbcdReg) disasm() string {
+	return fmt.Sprintf("abcd d%d, d%d", instr.regY, instr.regX)
+}
+func (instr instrAbcdReg) exec(ctx *clientContext) error {
+	res := ctx.abcdAndSetFlags(ctx.readDregB(instr.regX), ctx.readDregB(instr.regY))
+	ctx.writeDregB(instr.regX, res)
+	return nil
+}
+
+// ABCD -(Ay), -(Ax)
+func (instr instrAbcdMem) disasm() string {
+	return fmt.Sprintf("abcd -(a%d), -(a%d)", instr.regY, instr.regX)
+}
+func (instr instrAbcdMem) exec(ctx *clientContext) error {
+	return ctx.execXMem(instr.regY, instr.regX, opsizeByte, func(dest, src uint32) uint32 {
+		return uint32(ctx.abcdAndSetFlags(uint8(dest), uint8(src)))
+	})
+}
+
+// SBCD Dy, Dx
+func (instr instrSbcdReg) disasm() string {
+	return fmt.Sprintf("sbcd d%d, d%d", instr.regY, instr.regX)
+}
+func (instr instrSbcdReg) exec(ctx *clientContext) error {
+	res := ctx.sbcdAndSetFlags(ctx.readDregB(instr.regX), ctx.readDregB(instr.regY))
+	ctx.writeDregB(instr.regX, res)
+	return nil
+}
+
+// SBCD -(Ay), -(Ax)
+func (instr instrSbcdMem) disasm() string {
+	return fmt.Sprintf("sbcd -(a%d), -(a%d)", instr.regY, instr.regX)
+}
+func (instr instrSbcdMem) exec(ctx *clientContext) error {
+	return ctx.execXMem(instr.regY, instr.regX, opsizeByte, func(dest, src uint32) uint32 {
+		return uint32(ctx.sbcdAndSetFlags(uint8(dest), uint8(src)))
+	})
+}
+
+// NBCD
+func (instr instrNbcd) disasm() string {
+	return fmt.Sprintf("nbcd %s", instr.ea1.ToString())
+}
+func (instr instrNbcd) exec(ctx *clientContext) error {
+	// NBCD is same as SBCD with 0 as the destination, including undocumented behaviors.
+	return ctx.readModifyWriteEa(*instr.ea1, opsizeByte, func(v uint32) uint32 {
+		return uint32(ctx.sbcdAndSetFlags(0, uint8(v)))
+	})
+}
+
+// Common code for -(Ay), -(Ax) form of ADDX, SUBX, ABCD and SBCD.
+// Source is read first, then destination, and result goes to the destination.
+func (ctx *clientContext) execXMem(regY uint8, regX uint8, size opsize, op func(dest, src uint32) uint32) error {
+	fc := ctx.getFuncCode(false)
+	// For long operands, 68000 reads and writes the low word first.
+	read := func(addr uint32) (uint32, error) {
+		if size == opsizeLong {
+			return ctx.readMemLLowFirst(addr, fc)
+		}
+		return ctx.readMem(addr, fc, size)
+	}
+	write := func(addr uint32, v uint32) error {
+		if size == opsizeLong {
+			return ctx.writeMemLLowFirst(addr, fc, v)
+		}
+		return ctx.writeMem(addr, fc, size, v)
+	}
+	srcAddr := ctx.decrementAreg(regY, size)
+	src, err := read(srcAddr)
+	if err != nil {
+		return err
+	}
+	destAddr := ctx.decrementAreg(regX, size)
+	dest, err := read(destAddr)
+	if err != nil {
+		return err
+	}
+	res := op(dest, src)
+	return write(destAddr, res)
+}
+
+// ==============================================================================
+// Instructions: Logical
+// ==============================================================================
+
+// AND <ea>, Dn
+func (instr instrAndEaDreg) disasm() string {
+	return fmt.Sprintf("and.%s %s, d%d", instr.size.ToString(), instr.ea1.ToString(), instr.regX)
+}
+func (instr instrAndEaDreg) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, instr.size)
+	if err != nil {
+		return err
+	}
+	res := ctx.logicalSetFlags(ctx.readDreg(instr.regX, instr.size)&src, instr.size)
+	ctx.writeDreg(instr.regX, instr.size, res)
+	return nil
+}
+
+// AND Dn, <ea>
+func (instr instrAndDregEa) disasm() string {
+	return fmt.Sprintf("and.%s d%d, %s", instr.size.ToString(), instr.regX, instr.ea1.ToString())
+}
+func (instr instrAndDregEa) exec(ctx *clientContext) error {
+	src := ctx.readDreg(instr.regX, instr.size)
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.logicalSetFlags(dest&src, instr.size)
+	})
+}
+
+// ANDI
+func (instr instrAndI) disasm() string {
+	return fmt.Sprintf("andi.%s #%#x, %s", instr.size.ToString(), instr.imm, instr.ea1.ToString())
+}
+func (instr instrAndI) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.logicalSetFlags(dest&instr.imm, instr.size)
+	})
+}
+
+// OR <ea>, Dn
+func (instr instrOrEaDreg) disasm() string {
+	return fmt.Sprintf("or.%s %s, d%d", instr.size.ToString(), instr.ea1.ToString(), instr.regX)
+}
+func (instr instrOrEaDreg) exec(ctx *clientContext) error {
+	src, err := ctx.readEa(*instr.ea1, instr.size)
+	if err != nil {
+		return err
+	}
+	res := ctx.logicalSetFlags(ctx.readDreg(instr.regX, instr.size)|src, instr.size)
+	ctx.writeDreg(instr.regX, instr.size, res)
+	return nil
+}
+
+// OR Dn, <ea>
+func (instr instrOrDregEa) disasm() string {
+	return fmt.Sprintf("or.%s d%d, %s", instr.size.ToString(), instr.regX, instr.ea1.ToString())
+}
+func (instr instrOrDregEa) exec(ctx *clientContext) error {
+	src := ctx.readDreg(instr.regX, instr.size)
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.logicalSetFlags(dest|src, instr.size)
+	})
+}
+
+// ORI
+func (instr instrOrI) disasm() string {
+	return fmt.Sprintf("ori.%s #%#x, %s", instr.size.ToString(), instr.imm, instr.ea1.ToString())
+}
+func (instr instrOrI) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.logicalSetFlags(dest|instr.imm, instr.size)
+	})
+}
+
+// EOR
+func (instr instrEor) disasm() string {
+	return fmt.Sprintf("eor.%s d%d, %s", instr.size.ToString(), instr.regX, instr.ea1.ToString())
+}
+func (instr instrEor) exec(ctx *clientContext) error {
+	src := ctx.readDreg(instr.regX, instr.size)
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.logicalSetFlags(dest^src, instr.size)
+	})
+}
+
+// EORI
+func (instr instrEorI) disasm() string {
+	return fmt.Sprintf("eori.%s #%#x, %s", instr.size.ToString(), instr.imm, instr.ea1.ToString())
+}
+func (instr instrEorI) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.logicalSetFlags(dest^instr.imm, instr.size)
+	})
+}
+
+// NOT
+func (instr instrNot) disasm() string {
+	return fmt.Sprintf("not.%s %s", instr.size.ToString(), instr.ea1.ToString())
+}
+func (instr instrNot) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(dest uint32) uint32 {
+		return ctx.logicalSetFlags(^dest, instr.size)
+	})
+}
+
+// ==============================================================================
+// Instructions: Unary/Conditional set
+// ==============================================================================
+
+// NEGX
+func (instr instrNegX) disasm() string {
+	return fmt.Sprintf("negx.%s %s", instr.size.ToString(), instr.ea1.ToString())
+}
+func (instr instrNegX) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(v uint32) uint32 {
+		return ctx.subAndSetFlags(0, v, instr.size, true)
+	})
+}
+
+// CLR
+func (instr instrClr) disasm() string {
+	return fmt.Sprintf("clr.%s %s", instr.size.ToString(), instr.ea1.ToString())
+}
+func (instr instrClr) exec(ctx *clientContext) error {
+	// 68000 reads the destination before clearing it, so we do read-modify-write here.
+	err := ctx.readModifyWriteEa(*instr.ea1, instr.size, func(uint32) uint32 {
+		return 0
+	})
+	if err != nil {
+		return err
+	}
+	ctx.setNZFlags(0, instr.size)
+	ctx.clearVCFlags()
+	return nil
+}
+
+// NEG
+func (instr instrNeg) disasm() string {
+	return fmt.Sprintf("neg.%s %s", instr.size.ToString(), instr.ea1.ToString())
+}
+func (instr instrNeg) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, instr.size, func(v uint32) uint32 {
+		return ctx.subAndSetFlags(0, v, instr.size, false)
+	})
+}
+
+// TAS
+func (instr instrTas) disasm() string {
+	return fmt.Sprintf("tas %s", instr.ea1.ToString())
+}
+func (instr instrTas) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, opsizeByte, func(v uint32) uint32 {
+		ctx.setNZFlagsB(uint8(v))
+		ctx.clearVCFlags()
+		return v | 0x80
+	})
+}
+
+// Scc
+func (instr instrScc) disasm() string {
+	return fmt.Sprintf("s%s %s", instr.cond.ToString(), instr.ea1.ToString())
+}
+func (instr instrScc) exec(ctx *clientContext) error {
+	res := uint32(0x00)
+	if ctx.testCond(instr.cond) {
+		res = 0xff
+	}
+	// Like CLR, 68000 reads the destination before writing to it.
+	return ctx.readModifyWriteEa(*instr.ea1, opsizeByte, func(uint32) uint32 {
+		return res
+	})
+}
+
+// ==============================================================================
+// Instructions: Shift/Rotate
+// ==============================================================================
+
+// ASL, ASR, LSL, LSR, ROL, ROR, ROXL, ROXR with immediate count
+func (instr instrShiftImm) disasm() string {
+	return fmt.Sprintf("%s.%s #%d, d%d", shiftToString(instr.kind, instr.dir), instr.size.ToString(), quickImm(instr.imm), instr.regY)
+}
+func (instr instrShiftImm) exec(ctx *clientContext) error {
+	v := ctx.readDreg(instr.regY, instr.size)
+	v = ctx.shiftAndSetFlags(instr.kind, instr.dir, v, quickImm(instr.imm), instr.size)
+	ctx.writeDreg(instr.regY, instr.size, v)
+	return nil
+}
+
+// ASL, ASR, LSL, LSR, ROL, ROR, ROXL, ROXR with count in data register
+func (instr instrShiftReg) disasm() string {
+	return fmt.Sprintf("%s.%s d%d, d%d", shiftToString(instr.kind, instr.dir), instr.size.ToString(), instr.regX, instr.regY)
+}
+func (instr instrShiftReg) exec(ctx *clientContext) error {
+	count := ctx.readDregL(instr.regX) % 64
+	v := ctx.readDreg(instr.regY, instr.size)
+	v = ctx.shiftAndSetFlags(instr.kind, instr.dir, v, count, instr.size)
+	ctx.writeDreg(instr.regY, instr.size, v)
+	return nil
+}
+
+// ASL, ASR, LSL, LSR, ROL, ROR, ROXL, ROXR on memory (Always word-sized, and shifts by 1 bit)
+func (instr instrShiftMem) disasm() string {
+	return fmt.Sprintf("%s %s", shiftToString(instr.kind, instr.dir), instr.ea1.ToString())
+}
+func (instr instrShiftMem) exec(ctx *clientContext) error {
+	return ctx.readModifyWriteEa(*instr.ea1, opsizeWord, func(v uint32) uint32 {
+		return ctx.shiftAndSetFlags(instr.kind, instr.dir, v, 1, opsizeWord)
+	})
+}
+
+// ==============================================================================
+// Instructions: Bit manipulation
+// ==============================================================================
+
+type bitOp uint8
+
+const (
+	bitOpTst = bitOp(iota)
+	bitOpChg
+	bitOpClr
+	bitOpSet
+)
+
+// Bit operations work on 32-bit values if the operand is data register, and 8-bit values otherwise.
+// Bit number is taken as modulo of that size.
+func bitNumForEa(ea ea, bitNum uint32) uint32 {
+	if ea.mode == eamodeDreg {
+		return bitNum % 32
+	}
+	return bitNum % 8
+}
+
+func (ctx *clientContext) execBitOp(op bitOp, ea ea, bitNum uint32) error {
+	size := opsizeByte
+	if ea.mode == eamodeDreg {
+		size = opsizeLong
+	}
+	mask := uint32(1) << bitNumForEa(ea, bitNum)
+	if op == bitOpTst {
+		v, err := ctx.readEa(ea, size)
+		if err != nil {
+			return err
+		}
+		ctx.ccrZ = (v & mask) == 0
+		return nil
+	}
+	return ctx.readModifyWriteEa(ea, size, func(v uint32) uint32 {
+		// Z flag reflects the bit value *before* modifying it.
+		ctx.ccrZ = (v & mask) == 0
+		switch op {
+		case bitOpChg:
+			return v ^ mask
+		case bitOpClr:
+			return v & ^mask
+		case bitOpSet:
+			return v | mask
+		}
+		panic("bad bitOp")
+	})
+}
+
+// BTST #<n>, <ea>
+func (instr instrBtstImm) disasm() string {
+	return fmt.Sprintf("btst #%d, %s", instr.bitNum, instr.ea1.ToString())
+}
+func (instr instrBtstImm) exec(ctx *clientContext) error {
+	return ctx.execBitOp(bitOpTst, *instr.ea1, uint32(instr.bitNum))
+}
+
+// BCHG #<n>, <ea>
+func (instr instrBchgImm) disasm() string {
+	return fmt.Sprintf("bchg #%d, %s", instr.bitNum, instr.ea1.ToString())
+}
+func (instr instrBchgImm) exec(ctx *clientContext) error {
+	return ctx.execBitOp(bitOpChg, *instr.ea1, uint32(instr.bitNum))
+}
+
+// BCLR #<n>, <ea>
+func (instr instrBclrImm) disasm() string {
+	return fmt.Sprintf("bclr #%d, %s", instr.bitNum, instr.ea1.ToString())
+}
+func (instr instrBclrImm) exec(ctx *clientContext) error {
+	return ctx.execBitOp(bitOpClr, *instr.ea1, uint32(instr.bitNum))
+}
+
+// BSET #<n>, <ea>
+func (instr instrBsetImm) disasm() string {
+	return fmt.Sprintf("bset #%d, %s", instr.bitNum, instr.ea1.ToString())
+}
+func (instr instrBsetImm) exec(ctx *clientContext) error {
+	return ctx.execBitOp(bitOpSet, *instr.ea1, uint32(instr.bitNum))
+}
+
+// BTST Dn, <ea>
+func (instr instrBtstReg) disasm() string {
+	return fmt.Sprintf("btst d%d, %s", instr.regX, instr.ea1.ToString())
+}
+func (instr instrBtstReg) exec(ctx *clientContext) error {
+	return ctx.execBitOp(bitOpTst, *instr.ea1, ctx.readDregL(instr.regX))
+}
+
+// BCHG Dn, <ea>
+func (instr instrBchgReg) disasm() string {
+	return fmt.Sprintf("bchg d%d, %s", instr.regX, instr.ea1.ToString())
+}
+func (instr instrBchgReg) exec(ctx *clientContext) error {
+	return ctx.execBitOp(bitOpChg, *instr.ea1, ctx.readDregL(instr.regX))
+}
+
+// BCLR Dn, <ea>
+func (instr instrBclrReg) disasm() string {
+	return fmt.Sprintf("bclr d%d, %s", instr.regX, instr.ea1.ToString())
+}
+func (instr instrBclrReg) exec(ctx *clientContext) error {
+	return ctx.execBitOp(bitOpClr, *instr.ea1, ctx.readDregL(instr.regX))
+}
+
+// BSET Dn, <ea>
+func (instr instrBsetReg) disasm() string {
+	return fmt.Sprintf("bset d%d, %s", instr.regX, instr.ea1.ToString())
+}
+func (instr instrBsetReg) exec(ctx *clientContext) error {
+	return ctx.execBitOp(bitOpSet, *instr.ea1, ctx.readDregL(instr.regX))
+}
+
+// ==============================================================================
+// Instructions: SR/CCR
+//
+// Note that A7 always refers to the stack pointer selected by S bit, so changing S also switches the stack.
+// ==============================================================================
+
+// MOVE SR, <ea>
+func (instr instrMoveFromSr) disasm() string {
+	return fmt.Sprintf("move sr, %s", instr.ea1.ToString())
+}
+func (instr instrMoveFromSr) exec(ctx *clientContext) error {
+	// This is not privileged on 68000.
+	// 68000 reads the destination before writing to it, so we do read-modify-write here.
+	sr := ctx.readSr()
+	return ctx.readModifyWriteEa(*instr.ea1, opsizeWord, func(uint32) uint32 {
+		return uint32(sr)
+	})
+}
+
+// MOVE <ea>, CCR
+func (instr instrMoveToCcr) disasm() string {
+	return fmt.Sprintf("move %s, ccr", instr.ea1.ToString())
+}
+func (instr instrMoveToCcr) exec(ctx *clientContext) error {
+	// Source is word sized, but only lower 8-bit is used.
+	if v, err := ctx.readEa(*instr.ea1, opsizeWord); err != nil {
 		return err
 	} else {
-		src = uint8(v)
+		ctx.writeCcr(uint8(v))
+	}
+	return nil
+}
+
+// MOVE <ea>, SR
+func (instr instrMoveToSr) disasm() string {
+	return fmt.Sprintf("move %s, sr", instr.ea1.ToString())
+}
+func (instr instrMoveToSr) exec(ctx *clientContext) error {
+	if !ctx.srS {
+		return excError{exc: excPrivilegeViolation}
 	}
-	if err := ctx.writeEa(*instr.ea2, opsizeByte, uint32(src)); err != nil {
+	if v, err := ctx.readEa(*instr.ea1, opsizeWord); err != nil {
 		return err
+	} else {
+		ctx.writeSr(uint16(v))
+	}
+	return nil
+}
+
+// ANDI to CCR
+func (instr instrAndIToCcr) disasm() string {
+	return fmt.Sprintf("andi #%#x, ccr", instr.imm8)
+}
+func (instr instrAndIToCcr) exec(ctx *clientContext) error {
+	ctx.writeCcr(ctx.readCcr() & instr.imm8)
+	return nil
+}
+
+// ANDI to SR
+func (instr instrAndIToSr) disasm() string {
+	return fmt.Sprintf("andi #%#x, sr", instr.imm16)
+}
+func (instr instrAndIToSr) exec(ctx *clientContext) error {
+	if !ctx.srS {
+		return excError{exc: excPrivilegeViolation}
+	}
+	ctx.writeSr(ctx.readSr() & instr.imm16)
+	return nil
+}
+
+// ORI to CCR
+func (instr instrOrIToCcr) disasm() string {
+	return fmt.Sprintf("ori #%#x, ccr", instr.imm8)
+}
+func (instr instrOrIToCcr) exec(ctx *clientContext) error {
+	ctx.writeCcr(ctx.readCcr() | instr.imm8)
+	return nil
+}
+
+// ORI to SR
+func (instr instrOrIToSr) disasm() string {
+	return fmt.Sprintf("ori #%#x, sr", instr.imm16)
+}
+func (instr instrOrIToSr) exec(ctx *clientContext) error {
+	if !ctx.srS {
+		return excError{exc: excPrivilegeViolation}
+	}
+	ctx.writeSr(ctx.readSr() | instr.imm16)
+	return nil
+}
+
+// EORI to CCR
+func (instr instrEorIToCcr) disasm() string {
+	return fmt.Sprintf("eori #%#x, ccr", instr.imm8)
+}
+func (instr instrEorIToCcr) exec(ctx *clientContext) error {
+	ctx.writeCcr(ctx.readCcr() ^ instr.imm8)
+	return nil
+}
+
+// EORI to SR
+func (instr instrEorIToSr) disasm() string {
+	return fmt.Sprintf("eori #%#x, sr", instr.imm16)
+}
+func (instr instrEorIToSr) exec(ctx *clientContext) error {
+	if !ctx.srS {
+		return excError{exc: excPrivilegeViolation}
+	}
+	ctx.writeSr(ctx.readSr() ^ instr.imm16)
+	return nil
+}
+
+// ==============================================================================
+// Instructions: MOVEM
+//
+// In register list mask, bit 0~7 are D0~D7 and 8~15 are A0~A7.
+// Except for -(An) mode, where the mask is reversed(bit 0 is A7, and bit 15 is D0).
+// ==============================================================================
+
+// Returns register list string(e.g. d0-d3/a0/a6) for given mask. Mask must be in normal order.
+func regListToString(mask uint16) string {
+	parts := []string{}
+	for i := 0; i < 16; {
+		if (mask & (1 << i)) == 0 {
+			i++
+			continue
+		}
+		// Find the end of the range, without crossing D7-A0 boundary.
+		end := i
+		for ((end+1)%8 != 0) && ((mask & (1 << (end + 1))) != 0) {
+			end++
+		}
+		name := func(n int) string {
+			if n < 8 {
+				return fmt.Sprintf("d%d", n)
+			}
+			return fmt.Sprintf("a%d", n-8)
+		}
+		if end == i {
+			parts = append(parts, name(i))
+		} else {
+			parts = append(parts, name(i)+"-"+name(end))
+		}
+		i = end + 1
+	}
+	return strings.Join(parts, "/")
+}
+
+func (ctx *clientContext) readMovemReg(n int) uint32 {
+	if n < 8 {
+		return ctx.readDregL(uint8(n))
+	}
+	return ctx.readAreg(uint8(n - 8))
+}
+func (ctx *clientContext) writeMovemReg(n int, v uint32) {
+	if n < 8 {
+		ctx.writeDregL(uint8(n), v)
+	} else {
+		ctx.writeAregL(uint8(n-8), v)
+	}
+}
+
+// MOVEM <list>, <ea>
+func (instr instrMovemToMem) disasm() string {
+	mask := instr.regList
+	if instr.ea1.mode == eamodeAregIndPredec {
+		mask = bits.Reverse16(mask)
+	}
+	return fmt.Sprintf("movem.%s %s, %s", instr.size.ToString(), regListToString(mask), instr.ea1.ToString())
+}
+func (instr instrMovemToMem) exec(ctx *clientContext) error {
+	fc := ctx.getFuncCode(false)
+	incr := uint32(2)
+	if instr.size == opsizeLong {
+		incr = 4
+	}
+	if instr.ea1.mode == eamodeAregIndPredec {
+		// Registers are stored from A7 to D0, going down in memory.
+		// Note that if An itself is in the list, its initial value is stored.
+		reg := instr.ea1.reg()
+		addr := ctx.readAreg(reg)
+		for i := range 16 {
+			if (instr.regList & (1 << i)) == 0 {
+				continue
+			}
+			addr -= incr
+			v := ctx.readMovemReg(15 - i)
+			if instr.size == opsizeLong {
+				// Lower word is written first in this mode.
+				if err := ctx.writeMemW(addr+2, fc, uint16(v)); err != nil {
+					return err
+				}
+				if err := ctx.writeMemW(addr, fc, uint16(v>>16)); err != nil {
+					return err
+				}
+			} else {
+				if err := ctx.writeMemW(addr, fc, uint16(v)); err != nil {
+					return err
+				}
+			}
+		}
+		ctx.writeAregL(reg, addr)
+		return nil
+	}
+	addr := ctx.memAddrOfEa(*instr.ea1, opsizeNone)
+	for i := range 16 {
+		if (instr.regList & (1 << i)) == 0 {
+			continue
+		}
+		if err := ctx.writeMem(addr, fc, instr.size, ctx.readMovemReg(i)); err != nil {
+			return err
+		}
+		addr += incr
+	}
+	return nil
+}
+
+// MOVEM <ea>, <list>
+func (instr instrMovemToReg) disasm() string {
+	return fmt.Sprintf("movem.%s %s, %s", instr.size.ToString(), instr.ea1.ToString(), regListToString(instr.regList))
+}
+func (instr instrMovemToReg) exec(ctx *clientContext) error {
+	fc := ctx.getFuncCode(false)
+	incr := uint32(2)
+	if instr.size == opsizeLong {
+		incr = 4
+	}
+	addr := uint32(0)
+	if instr.ea1.mode == eamodeAregIndPostinc {
+		addr = ctx.readAreg(instr.ea1.reg())
+	} else {
+		addr = ctx.memAddrOfEa(*instr.ea1, opsizeNone)
+	}
+	for i := range 16 {
+		if (instr.regList & (1 << i)) == 0 {
+			continue
+		}
+		v, err := ctx.readMem(addr, fc, instr.size)
+		if err != nil {
+			return err
+		}
+		if instr.size == opsizeWord {
+			// Words are sign-extended, even for data registers.
+			v = signExtendWToL(uint16(v))
+		}
+		ctx.writeMovemReg(i, v)
+		addr += incr
+	}
+	// 68000 reads one more word after the last register. The value is discarded.
+	if _, err := ctx.readMemW(addr, fc); err != nil {
+		return err
+	}
+	if instr.ea1.mode == eamodeAregIndPostinc {
+		// If An itself was in the list, the loaded value is overwritten here.
+		ctx.writeAregL(instr.ea1.reg(), addr)
+	}
+	return nil
+}
+
+// ==============================================================================
+// Instructions: MOVEP
+//
+// MOVEP transfers data to/from every other byte in memory, starting from (d16, An) and going up.
+// Each byte is transferred in its own bus cycle, so only one of data strobes is active in each cycle.
+// ==============================================================================
+
+func (ctx *clientContext) execMovepToReg(dataReg uint8, addrReg uint8, disp uint16, size opsize) error {
+	fc := ctx.getFuncCode(false)
+	addr := ctx.readAreg(addrReg) + signExtendWToL(disp)
+	count := 2
+	if size == opsizeLong {
+		count = 4
+	}
+	res := uint32(0)
+	for range count {
+		v, err := ctx.readMemB(addr, fc)
+		if err != nil {
+			return err
+		}
+		res = (res << 8) | uint32(v)
+		addr += 2
+	}
+	ctx.writeDreg(dataReg, size, res)
+	return nil
+}
+
+func (ctx *clientContext) execMovepToMem(dataReg uint8, addrReg uint8, disp uint16, size opsize) error {
+	fc := ctx.getFuncCode(false)
+	addr := ctx.readAreg(addrReg) + signExtendWToL(disp)
+	count := 2
+	if size == opsizeLong {
+		count = 4
+	}
+	v := ctx.readDreg(dataReg, size)
+	for i := range count {
+		// Most significant byte comes first.
+		shift := uint32(count-1-i) * 8
+		if err := ctx.writeMemB(addr, fc, uint8(v>>shift)); err != nil {
+			return err
+		}
+		addr += 2
 	}
-	ctx.setNZFlagsB(src)
-	ctx.clearVCFlags()
 	return nil
 }
 
+// MOVEP.w (d16, Ay), Dx
+func (instr instrMovepToRegW) disasm() string {
+	return fmt.Sprintf("movep.w (%d, a%d), d%d", int16(instr.imm16), instr.regY, instr.regX)
+}
+func (instr instrMovepToRegW) exec(ctx *clientContext) error {
+	return ctx.execMovepToReg(instr.regX, instr.regY, instr.imm16, opsizeWord)
+}
+
+// MOVEP.l (d16, Ay), Dx
+func (instr instrMovepToRegL) disasm() string {
+	return fmt.Sprintf("movep.l (%d, a%d), d%d", int16(instr.imm16), instr.regY, instr.regX)
+}
+func (instr instrMovepToRegL) exec(ctx *clientContext) error {
+	return ctx.execMovepToReg(instr.regX, instr.regY, instr.imm16, opsizeLong)
+}
+
+// MOVEP.w Dx, (d16, Ay)
+func (instr instrMovepToMemW) disasm() string {
+	return fmt.Sprintf("movep.w d%d, (%d, a%d)", instr.regX, int16(instr.imm16), instr.regY)
+}
+func (instr instrMovepToMemW) exec(ctx *clientContext) error {
+	return ctx.execMovepToMem(instr.regX, instr.regY, instr.imm16, opsizeWord)
+}
+
+// MOVEP.l Dx, (d16, Ay)
+func (instr instrMovepToMemL) disasm() string {
+	return fmt.Sprintf("movep.l d%d, (%d, a%d)", instr.regX, int16(instr.imm16), instr.regY)
+}
+func (instr instrMovepToMemL) exec(ctx *clientContext) error {
+	return ctx.execMovepToMem(instr.regX, instr.regY, instr.imm16, opsizeLong)
+}
+
 // ==============================================================================
 // Instructions: Branching
 //
@@ -2242,12 +4483,36 @@ func (instr instrUnlk) exec(ctx *clientContext) error {
 	return nil
 }
 
+// CHK
+func (instr instrChk) disasm() string {
+	return fmt.Sprintf("chk.w %s, d%d", instr.ea1.ToString(), instr.regX)
+}
+func (instr instrChk) exec(ctx *clientContext) error {
+	bound, err := ctx.readEa(*instr.ea1, opsizeWord)
+	if err != nil {
+		return err
+	}
+	dn := int16(ctx.readDregW(instr.regX))
+	// Z, V, C are undefined according to the manual, but this is what 68000 actually does.
+	ctx.ccrZ = dn == 0
+	ctx.clearVCFlags()
+	// N is only defined when the exception occurs: It's set if Dn < 0, and cleared if Dn > bound.
+	if dn < 0 {
+		ctx.ccrN = true
+		return excError{exc: excChk}
+	} else if int16(bound) < dn {
+		ctx.ccrN = false
+		return excError{exc: excChk}
+	}
+	return nil
+}
+
 // TRAP
 func (instr instrTrap) disasm() string {
 	return fmt.Sprintf("trap #%d", instr.vector)
 }
 func (instr instrTrap) exec(ctx *clientContext) error {
-	return ctx.beginExc(excError{exc: excTrapVectorStart + exc(instr.vector)})
+	return excError{exc: excTrapVectorStart + exc(instr.vector)}
 }
 
 // TRAPV
@@ -2258,7 +4523,7 @@ func (instr instrTrapV) exec(ctx *clientContext) error {
 	if !ctx.ccrV {
 		return nil
 	}
-	return ctx.beginExc(excError{exc: excTrapv})
+	return excError{exc: excTrapv}
 }
 
 // EXT.w
@@ -2360,6 +4625,20 @@ func (instr instrSwap) exec(ctx *clientContext) error {
 	return nil
 }
 
+// STOP
+func (instr instrStop) disasm() string {
+	return fmt.Sprintf("stop #%#x", instr.imm16)
+}
+func (instr instrStop) exec(ctx *clientContext) error {
+	if !ctx.srS {
+		return excError{exc: excPrivilegeViolation}
+	}
+	// CPU stays stopped until an interrupt(or Unstop command) arrives. PC is already pointing at the next instruction.
+	ctx.writeSr(instr.imm16)
+	ctx.stopped = true
+	return nil
+}
+
 // ILLEGAL
 func (instr instrIllegal) disasm() string {
 	return "illegal"
@@ -13,42 +13,141 @@ import (
 // They are only there for the source code formatting.
 var records []record = []record{
 	// MOVE --------------------------------------------------------------------
-	{"MoveB", "0001bbbbbbcccccc", []*field{fieldEa1, fieldEa2}, nil, eamodeFlagAll, eamodeFlagData & eamodeFlagAlter},
-	// {"MoveW", "0011bbbbbbcccccc", []*field{fieldEa1, fieldEa2}, nil, eamodeFlagAll, eamodeFlagData & eamodeFlagAlter},
-	// {"MoveL", "0010bbbbbbcccccc", []*field{fieldEa1, fieldEa2}, nil, eamodeFlagAll, eamodeFlagData & eamodeFlagAlter},
+	{"MoveAW", "0011aaa001bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagAll, eamodeFlagNone},
+	{"MoveAL", "0010aaa001bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeLong, eamodeFlagAll, eamodeFlagNone},
+	{"MoveB ", "0001bbbbbbcccccc", []*field{fieldEa1, fieldEa2}, nil, opsizeByte, eamodeFlagAll &^ eamodeFlagAreg, eamodeFlagData & eamodeFlagAlter},
+	{"MoveW ", "0011bbbbbbcccccc", []*field{fieldEa1, fieldEa2}, nil, opsizeWord, eamodeFlagAll, eamodeFlagData & eamodeFlagAlter},
+	{"MoveL ", "0010bbbbbbcccccc", []*field{fieldEa1, fieldEa2}, nil, opsizeLong, eamodeFlagAll, eamodeFlagData & eamodeFlagAlter},
+
+	// MOVEQ -------------------------------------------------------------------
+	{"MoveQ ", "0111aaa0bbbbbbbb", []*field{fieldRegX, fieldImm8}, nil, opsizeLong, eamodeFlagNone, eamodeFlagNone},
+
+	// Arithmetic --------------------------------------------------------------
+	{"AddAW    ", "1101aaa011bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagAll, eamodeFlagNone},
+	{"AddAL    ", "1101aaa111bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeLong, eamodeFlagAll, eamodeFlagNone},
+	{"AddXReg  ", "1101aaa1bb000ccc", []*field{fieldRegX, fieldSize1, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"AddXMem  ", "1101aaa1bb001ccc", []*field{fieldRegX, fieldSize1, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"AddEaDreg", "1101aaa0bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagAll, eamodeFlagNone},
+	{"AddDregEa", "1101aaa1bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagMem & eamodeFlagAlter, eamodeFlagNone},
+	{"AddI     ", "00000110aabbbbbb", []*field{fieldSize1, fieldEa1}, xwordImm, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"AddQ     ", "0101aaa0bbcccccc", []*field{fieldImm3, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagAlter, eamodeFlagNone},
+	{"SubAW    ", "1001aaa011bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagAll, eamodeFlagNone},
+	{"SubAL    ", "1001aaa111bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeLong, eamodeFlagAll, eamodeFlagNone},
+	{"SubXReg  ", "1001aaa1bb000ccc", []*field{fieldRegX, fieldSize1, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"SubXMem  ", "1001aaa1bb001ccc", []*field{fieldRegX, fieldSize1, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"SubEaDreg", "1001aaa0bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagAll, eamodeFlagNone},
+	{"SubDregEa", "1001aaa1bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagMem & eamodeFlagAlter, eamodeFlagNone},
+	{"SubI     ", "00000100aabbbbbb", []*field{fieldSize1, fieldEa1}, xwordImm, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"SubQ     ", "0101aaa1bbcccccc", []*field{fieldImm3, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagAlter, eamodeFlagNone},
+	{"AbcdReg  ", "1100aaa100000bbb", []*field{fieldRegX, fieldRegY}, nil, opsizeByte, eamodeFlagNone, eamodeFlagNone},
+	{"AbcdMem  ", "1100aaa100001bbb", []*field{fieldRegX, fieldRegY}, nil, opsizeByte, eamodeFlagNone, eamodeFlagNone},
+	{"SbcdReg  ", "1000aaa100000bbb", []*field{fieldRegX, fieldRegY}, nil, opsizeByte, eamodeFlagNone, eamodeFlagNone},
+	{"SbcdMem  ", "1000aaa100001bbb", []*field{fieldRegX, fieldRegY}, nil, opsizeByte, eamodeFlagNone, eamodeFlagNone},
+	{"Nbcd     ", "0100100000aaaaaa", []*field{fieldEa1}, nil, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+
+	// Compare -----------------------------------------------------------------
+	{"CmpAW    ", "1011aaa011bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagAll, eamodeFlagNone},
+	{"CmpAL    ", "1011aaa111bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeLong, eamodeFlagAll, eamodeFlagNone},
+	{"CmpM     ", "1011aaa1bb001ccc", []*field{fieldRegX, fieldSize1, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Cmp      ", "1011aaa0bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagAll, eamodeFlagNone},
+	{"CmpI     ", "00001100aabbbbbb", []*field{fieldSize1, fieldEa1}, xwordImm, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"Tst      ", "01001010aabbbbbb", []*field{fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+
+	// Multiply/Divide ---------------------------------------------------------
+	{"MulU     ", "1100aaa011bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagData, eamodeFlagNone},
+	{"MulS     ", "1100aaa111bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagData, eamodeFlagNone},
+	{"DivU     ", "1000aaa011bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagData, eamodeFlagNone},
+	{"DivS     ", "1000aaa111bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagData, eamodeFlagNone},
+
+	// Logical -----------------------------------------------------------------
+	{"AndEaDreg", "1100aaa0bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagData, eamodeFlagNone},
+	{"AndDregEa", "1100aaa1bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagMem & eamodeFlagAlter, eamodeFlagNone},
+	{"AndI     ", "00000010aabbbbbb", []*field{fieldSize1, fieldEa1}, xwordImm, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"OrEaDreg ", "1000aaa0bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagData, eamodeFlagNone},
+	{"OrDregEa ", "1000aaa1bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagMem & eamodeFlagAlter, eamodeFlagNone},
+	{"OrI      ", "00000000aabbbbbb", []*field{fieldSize1, fieldEa1}, xwordImm, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"Eor      ", "1011aaa1bbcccccc", []*field{fieldRegX, fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"EorI     ", "00001010aabbbbbb", []*field{fieldSize1, fieldEa1}, xwordImm, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"Not      ", "01000110aabbbbbb", []*field{fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+
+	// SR/CCR ------------------------------------------------------------------
+	{"MoveFromSr", "0100000011aaaaaa", []*field{fieldEa1}, nil, opsizeWord, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"MoveToCcr ", "0100010011aaaaaa", []*field{fieldEa1}, nil, opsizeWord, eamodeFlagData, eamodeFlagNone},
+	{"MoveToSr  ", "0100011011aaaaaa", []*field{fieldEa1}, nil, opsizeWord, eamodeFlagData, eamodeFlagNone},
+	{"AndIToCcr ", "0000001000111100", []*field{}, xwordImm8, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"AndIToSr  ", "0000001001111100", []*field{}, xwordImm16, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"OrIToCcr  ", "0000000000111100", []*field{}, xwordImm8, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"OrIToSr   ", "0000000001111100", []*field{}, xwordImm16, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"EorIToCcr ", "0000101000111100", []*field{}, xwordImm8, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"EorIToSr  ", "0000101001111100", []*field{}, xwordImm16, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+
+	// Unary/Conditional set ---------------------------------------------------
+	{"NegX", "01000000aabbbbbb", []*field{fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"Clr ", "01000010aabbbbbb", []*field{fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"Neg ", "01000100aabbbbbb", []*field{fieldSize1, fieldEa1}, nil, opsizeNone, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"Tas ", "0100101011aaaaaa", []*field{fieldEa1}, nil, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"Scc ", "0101aaaa11bbbbbb", []*field{fieldCond, fieldEa1}, nil, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+
+	// Shift/Rotate ------------------------------------------------------------
+	{"ShiftImm", "1110aaabcc0ddeee", []*field{fieldImm3, fieldShiftDir, fieldSize1, fieldShiftKind, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"ShiftReg", "1110aaabcc1ddeee", []*field{fieldRegX, fieldShiftDir, fieldSize1, fieldShiftKind, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"ShiftMem", "11100aab11cccccc", []*field{fieldShiftKindMem, fieldShiftDir, fieldEa1}, nil, opsizeWord, eamodeFlagMem & eamodeFlagAlter, eamodeFlagNone},
+
+	// MOVEP -------------------------------------------------------------------
+	{"MovepToRegW", "0000aaa100001bbb", []*field{fieldRegX, fieldRegY}, xwordImm16, opsizeWord, eamodeFlagNone, eamodeFlagNone},
+	{"MovepToRegL", "0000aaa101001bbb", []*field{fieldRegX, fieldRegY}, xwordImm16, opsizeLong, eamodeFlagNone, eamodeFlagNone},
+	{"MovepToMemW", "0000aaa110001bbb", []*field{fieldRegX, fieldRegY}, xwordImm16, opsizeWord, eamodeFlagNone, eamodeFlagNone},
+	{"MovepToMemL", "0000aaa111001bbb", []*field{fieldRegX, fieldRegY}, xwordImm16, opsizeLong, eamodeFlagNone, eamodeFlagNone},
+
+	// Bit manipulation --------------------------------------------------------
+	{"BtstImm", "0000100000aaaaaa", []*field{fieldEa1}, xwordBitNum, opsizeByte, eamodeFlagData &^ eamodeFlagImm, eamodeFlagNone},
+	{"BchgImm", "0000100001aaaaaa", []*field{fieldEa1}, xwordBitNum, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"BclrImm", "0000100010aaaaaa", []*field{fieldEa1}, xwordBitNum, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"BsetImm", "0000100011aaaaaa", []*field{fieldEa1}, xwordBitNum, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"BtstReg", "0000aaa100bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeByte, eamodeFlagData, eamodeFlagNone},
+	{"BchgReg", "0000aaa101bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"BclrReg", "0000aaa110bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
+	{"BsetReg", "0000aaa111bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeByte, eamodeFlagData & eamodeFlagAlter, eamodeFlagNone},
 
 	// Branch ------------------------------------------------------------------
-	{"Bra ", "01100000bbbbbbbb", []*field{}, xwordBranchOff, eamodeFlagNone, eamodeFlagNone},
-	{"Bsr ", "01100001bbbbbbbb", []*field{}, xwordBranchOff, eamodeFlagNone, eamodeFlagNone},
-	{"Bcc ", "0110aaaabbbbbbbb", []*field{fieldCond}, xwordBranchOff, eamodeFlagNone, eamodeFlagNone},
-	{"Dbcc", "0101aaaa11001bbb", []*field{fieldCond, fieldRegY}, xwordImm16, eamodeFlagNone, eamodeFlagNone},
+	{"Bra ", "01100000bbbbbbbb", []*field{}, xwordBranchOff, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Bsr ", "01100001bbbbbbbb", []*field{}, xwordBranchOff, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Bcc ", "0110aaaabbbbbbbb", []*field{fieldCond}, xwordBranchOff, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Dbcc", "0101aaaa11001bbb", []*field{fieldCond, fieldRegY}, xwordImm16, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+
+	// MOVEM -------------------------------------------------------------------
+	{"MovemToMem", "010010001abbbbbb", []*field{fieldSize3, fieldEa1}, xwordRegList, opsizeNone, (eamodeFlagCtrl & eamodeFlagAlter) | eamodeFlagAregIndPredec, eamodeFlagNone},
+	{"MovemToReg", "010011001abbbbbb", []*field{fieldSize3, fieldEa1}, xwordRegList, opsizeNone, eamodeFlagCtrl | eamodeFlagAregIndPostinc, eamodeFlagNone},
 
 	// Misc(0100~) -------------------------------------------------------------
-	{"Lea        ", "0100aaa111bbbbbb", []*field{fieldRegX, fieldEa1}, nil, eamodeFlagCtrl, eamodeFlagNone},
-	{"Pea        ", "0100100001aaaaaa", []*field{fieldEa1}, nil, eamodeFlagCtrl, eamodeFlagNone},
-	{"Jmp        ", "0100111011aaaaaa", []*field{fieldEa1}, nil, eamodeFlagCtrl, eamodeFlagNone},
-	{"Jsr        ", "0100111010aaaaaa", []*field{fieldEa1}, nil, eamodeFlagCtrl, eamodeFlagNone},
-	{"Link       ", "0100111001010aaa", []*field{fieldRegY}, xwordImm16, eamodeFlagNone, eamodeFlagNone},
-	{"Unlk       ", "0100111001011aaa", []*field{fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"Swap       ", "0100100001000aaa", []*field{fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"MoveToUsp  ", "0100111001100aaa", []*field{fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"MoveFromUsp", "0100111001101aaa", []*field{fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"ExtW       ", "0100100010000bbb", []*field{fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"ExtL       ", "0100100011000bbb", []*field{fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"Trap       ", "010011100100aaaa", []*field{fieldVector}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"TrapV      ", "0100111001110110", []*field{}, nil, eamodeFlagNone, eamodeFlagNone},
-
-	{"ExgDReg    ", "1100aaa101000ccc", []*field{fieldRegX, fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"ExgAReg    ", "1100aaa101001ccc", []*field{fieldRegX, fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"ExgDAReg   ", "1100aaa110001ccc", []*field{fieldRegX, fieldRegY}, nil, eamodeFlagNone, eamodeFlagNone},
+	{"Lea        ", "0100aaa111bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeNone, eamodeFlagCtrl, eamodeFlagNone},
+	{"Pea        ", "0100100001aaaaaa", []*field{fieldEa1}, nil, opsizeNone, eamodeFlagCtrl, eamodeFlagNone},
+	{"Jmp        ", "0100111011aaaaaa", []*field{fieldEa1}, nil, opsizeNone, eamodeFlagCtrl, eamodeFlagNone},
+	{"Jsr        ", "0100111010aaaaaa", []*field{fieldEa1}, nil, opsizeNone, eamodeFlagCtrl, eamodeFlagNone},
+	{"Link       ", "0100111001010aaa", []*field{fieldRegY}, xwordImm16, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Unlk       ", "0100111001011aaa", []*field{fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Swap       ", "0100100001000aaa", []*field{fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"MoveToUsp  ", "0100111001100aaa", []*field{fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"MoveFromUsp", "0100111001101aaa", []*field{fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"ExtW       ", "0100100010000bbb", []*field{fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"ExtL       ", "0100100011000bbb", []*field{fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Chk        ", "0100aaa110bbbbbb", []*field{fieldRegX, fieldEa1}, nil, opsizeWord, eamodeFlagData, eamodeFlagNone},
+	{"Trap       ", "010011100100aaaa", []*field{fieldVector}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"TrapV      ", "0100111001110110", []*field{}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+
+	{"ExgDReg    ", "1100aaa101000ccc", []*field{fieldRegX, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"ExgAReg    ", "1100aaa101001ccc", []*field{fieldRegX, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"ExgDAReg   ", "1100aaa110001ccc", []*field{fieldRegX, fieldRegY}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+
+	{"Stop       ", "0100111001110010", []*field{}, xwordImm16, opsizeNone, eamodeFlagNone, eamodeFlagNone},
 
 	// Misc(Without any fields) ------------------------------------------------
-	{"Illegal", "0100101011111100", []*field{}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"Nop    ", "0100111001110001", []*field{}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"Rts    ", "0100111001110101", []*field{}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"Rtr    ", "0100111001110111", []*field{}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"Reset  ", "0100111001110000", []*field{}, nil, eamodeFlagNone, eamodeFlagNone},
-	{"Rte    ", "0100111001110011", []*field{}, nil, eamodeFlagNone, eamodeFlagNone},
+	{"Illegal", "0100101011111100", []*field{}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Nop    ", "0100111001110001", []*field{}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Rts    ", "0100111001110101", []*field{}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Rtr    ", "0100111001110111", []*field{}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Reset  ", "0100111001110000", []*field{}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
+	{"Rte    ", "0100111001110011", []*field{}, nil, opsizeNone, eamodeFlagNone, eamodeFlagNone},
 }
 
 func main() {
@@ -135,6 +234,10 @@ func main() {
 			emitln("err = nil")
 			emitln("resTemp := %s{}", rec.structName())
 			emitln("resTemp.instrPc = ctx.pc - 2")
+			emitln("ctx.decodingCtx.currInstrName = \"%s\"", strings.ToLower(strings.TrimSpace(rec.name)))
+			// Previous record may have left its EA fields and size behind, so we have to start fresh.
+			emitln("ctx.decodingCtx.eaFields = [2]*ea{}")
+			emitln("ctx.decodingCtx.opsize = %s", rec.size.constName())
 
 			// Check the bit pattern -------------------------------------------
 			emitBeginBlock("if (ctx.decodingCtx.ir & %#x) != %#x", fixedMask, fixedValue)
@@ -182,9 +285,9 @@ func main() {
 			// Call extension word decoder -------------------------------------
 			if rec.xword != nil {
 				fmt.Println(" - Xword:", rec.xword)
-				emitBeginBlock("if v, err := ctx.decodeXword%s(); err != nil", rec.xword.decoderName)
+				emitBeginBlock("if v, xwordErr := ctx.decodeXword%s(); xwordErr != nil", rec.xword.decoderName)
 				{
-					emitln("err = excError{exc: excIllegalInstr}")
+					emitln("err = xwordErr")
 					emitln("return")
 				}
 				emitEndBeginBlock("else")
@@ -228,6 +331,7 @@ type record struct {
 	bits   string     // Instruction bit pattern. 0/1 are fixed bits, others are for fields
 	fields []*field   // Instruction fields
 	xword  *xword     // Extension word type (nil if not present)
+	size   opsize     // Operation size, if it is fixed by the instruction (opsizeNone otherwise)
 	ea1    eamodeFlag // Effective address mode 1
 	ea2    eamodeFlag // Effective address mode 2 (if present)
 }
@@ -245,6 +349,8 @@ type field struct {
 }
 
 var (
+	fieldSize1  *field = &field{"opsize", "size", "SizeType1"}
+	fieldSize3  *field = &field{"opsize", "size", "SizeType3"}
 	fieldCond   *field = &field{"cond", "cond", "Cond"}
 	fieldEa1    *field = &field{"*ea", "ea1", "Ea1"}
 	fieldEa2    *field = &field{"*ea", "ea2", "Ea2"}
@@ -253,6 +359,10 @@ var (
 	fieldImm3   *field = &field{"uint8", "imm", "Imm3"}
 	fieldImm8   *field = &field{"uint8", "imm", "Imm8"}
 	fieldVector *field = &field{"uint8", "vector", "Vector"}
+
+	fieldShiftDir     *field = &field{"shiftDir", "dir", "ShiftDir"}
+	fieldShiftKind    *field = &field{"shiftKind", "kind", "ShiftKind"}
+	fieldShiftKindMem *field = &field{"shiftKind", "kind", "ShiftKindMem"}
 )
 
 // Instruction extension word
@@ -267,6 +377,8 @@ var (
 	xwordImm       *xword = &xword{"uint32", "imm", "Imm"}             // 8/16/32-bit immediate data (Determined based on operation size)
 	xwordImm8      *xword = &xword{"uint8", "imm8", "Imm8"}            // 8-bit immediate data
 	xwordImm16     *xword = &xword{"uint16", "imm16", "Imm16"}         // 16-bit immediate data
+	xwordBitNum    *xword = &xword{"uint8", "bitNum", "BitNum"}        // Bit number (Already reduced to the operand size)
+	xwordRegList   *xword = &xword{"uint16", "regList", "RegList"}     // Register list mask of MOVEM
 )
 
 const ()
@@ -385,11 +497,26 @@ func (eamode eamodeFlag) getConstNames() []string {
 type opsize uint8
 
 const (
-	opsizeByte = opsize(iota)
+	opsizeNone = opsize(iota)
+	opsizeByte
 	opsizeWord
 	opsizeLong
 )
 
+func (size opsize) constName() string {
+	switch size {
+	case opsizeNone:
+		return "opsizeNone"
+	case opsizeByte:
+		return "opsizeByte"
+	case opsizeWord:
+		return "opsizeWord"
+	case opsizeLong:
+		return "opsizeLong"
+	}
+	panic("bad opsize")
+}
+
 //==============================================================================
 // Source code output
 //==============================================================================